@@ -0,0 +1,142 @@
+package protogofakeit
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// bytesPlan is a compiled Bytes generator.
+type bytesPlan struct {
+	src *pb.Bytes
+	// source is the compiled tag, template, or pattern, if any.
+	source *generatorPlan
+}
+
+func (pf *protoFaker) compileBytes(src *pb.Bytes) (*bytesPlan, error) {
+	out := &bytesPlan{src: src}
+	var err error
+	if source := bytesSource(src); source != nil {
+		out.source, err = pf.compileGenerator(source, nil)
+	}
+	if rng := src.GetRandom().GetRange(); rng != nil && rng.GetMin() > rng.GetMax() {
+		err = errors.Join(err, fmt.Errorf("range minimum %d is greater than maximum %d", rng.GetMin(), rng.GetMax()))
+	}
+	return out, err
+}
+
+// bytesSource returns a Generator of the tag, template, or pattern of src, or
+// nil if it has none.
+func bytesSource(src *pb.Bytes) *pb.Generator {
+	switch {
+	case src.GetTag() != "":
+		return &pb.Generator{Apply: &pb.Generator_Tag{Tag: src.GetTag()}}
+	case src.GetTemplate() != "":
+		return &pb.Generator{Apply: &pb.Generator_Template{Template: src.GetTemplate()}}
+	case src.GetPattern() != "":
+		return &pb.Generator{Apply: &pb.Generator_Pattern{Pattern: src.GetPattern()}}
+	default:
+		return nil
+	}
+}
+
+// fakeBytes generates the value of the bytes field desc from the Bytes
+// generator of gen.
+func (pf *protoFaker) fakeBytes(
+	sc scope,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) (protoreflect.Value, error) {
+	src := gen.bytes.src
+	if source := gen.bytes.source; source != nil {
+		str, err := pf.fakeString(sc, source)
+		if err != nil {
+			return protoreflect.Value{}, newFieldError(desc, gen, "", err)
+		}
+		data, err := decodeBytes(src.GetEncoding(), str)
+		if err != nil {
+			return protoreflect.Value{}, newFieldError(desc, gen, str, err)
+		}
+		return protoreflect.ValueOfBytes(data), nil
+	}
+
+	switch src.GetShape() {
+	case pb.BytesShape_BYTES_SHAPE_UUID:
+		data := make([]byte, uuidLen)
+		_, _ = sc.faker.Rand.Read(data)
+		data[6] = data[6]&0x0f | 0x40 // version 4
+		data[8] = data[8]&0x3f | 0x80 // RFC 4122 variant
+		return protoreflect.ValueOfBytes(data), nil
+	case pb.BytesShape_BYTES_SHAPE_ULID:
+		data := make([]byte, ulidLen)
+		var millis [8]byte
+		ts := minULIDMillis + sc.faker.Rand.Int63n(maxULIDMillis-minULIDMillis+1)
+		binary.BigEndian.PutUint64(millis[:], uint64(ts)) //nolint:gosec // never negative
+		copy(data, millis[2:])
+		_, _ = sc.faker.Rand.Read(data[6:])
+		return protoreflect.ValueOfBytes(data), nil
+	case pb.BytesShape_BYTES_SHAPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return protoreflect.ValueOfBytes(pf.fakeRandomBytes(sc, src.GetRandom())), nil
+	}
+}
+
+// fakeRandomBytes generates random bytes per src, which may be nil.
+func (pf *protoFaker) fakeRandomBytes(sc scope, src *pb.RandomBytes) []byte {
+	n := pf.bytesSize.Fake(sc.faker)
+	switch {
+	case src.GetRange() != nil:
+		n = sc.faker.IntRange(int(src.GetRange().GetMin()), int(src.GetRange().GetMax()))
+	case src.GetSize() != nil:
+		n = int(src.GetLen())
+	}
+	data := make([]byte, n)
+	alphabet := src.GetAlphabet()
+	if len(alphabet) == 0 {
+		_, _ = sc.faker.Rand.Read(data)
+		return data
+	}
+	for i := range data {
+		data[i] = alphabet[sc.faker.Rand.Intn(len(alphabet))]
+	}
+	return data
+}
+
+// The lengths of binary UUIDs and ULIDs.
+const (
+	uuidLen = 16
+	ulidLen = 16
+)
+
+// The range of the timestamps of ULIDs, in milliseconds since the epoch, from
+// 2015-01-01 to 2025-01-01 (the same as smart-default timestamps). ULID
+// timestamps are drawn from a fixed range rather than relative to the current
+// time, so seeded output does not change over time.
+const (
+	minULIDMillis = 1_420_070_400_000
+	maxULIDMillis = 1_735_689_600_000
+)
+
+// decodeBytes decodes the generated string str per encoding.
+func decodeBytes(encoding pb.BytesEncoding, str string) ([]byte, error) {
+	switch encoding {
+	case pb.BytesEncoding_BYTES_ENCODING_HEX:
+		return hex.DecodeString(str)
+	case pb.BytesEncoding_BYTES_ENCODING_BASE64:
+		return base64.RawStdEncoding.DecodeString(strings.TrimRight(str, "="))
+	case pb.BytesEncoding_BYTES_ENCODING_BASE64URL:
+		return base64.RawURLEncoding.DecodeString(strings.TrimRight(str, "="))
+	case pb.BytesEncoding_BYTES_ENCODING_UNSPECIFIED,
+		pb.BytesEncoding_BYTES_ENCODING_RAW:
+		fallthrough
+	default:
+		return []byte(str), nil
+	}
+}
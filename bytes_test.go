@@ -0,0 +1,83 @@
+package protogofakeit
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBytes(t *testing.T) {
+	t.Parallel()
+
+	pf := initProtoFaker(t)
+	for range 20 {
+		msg := &test.Bytes{}
+		require.NoError(t, pf.FakeProto(msg))
+
+		assert.Len(t, msg.GetUuid(), uuidLen)
+		assert.Equal(t, byte(0x40), msg.GetUuid()[6]&0xf0)
+		assert.Equal(t, byte(0x80), msg.GetUuid()[8]&0xc0)
+
+		require.Len(t, msg.GetUlid(), ulidLen)
+		var millis [8]byte
+		copy(millis[2:], msg.GetUlid()[:6])
+		ts := time.UnixMilli(int64(binary.BigEndian.Uint64(millis[:]))).UTC() //nolint:gosec // 48 bits
+		assert.False(t, ts.Before(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)), ts)
+		assert.False(t, ts.After(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), ts)
+
+		assert.Equal(t, "deadbeef", hex.EncodeToString(msg.GetConstant()))
+		assert.Len(t, msg.GetSha256(), 32)
+		assert.Len(t, msg.GetToken(), 16)
+		assert.NotEmpty(t, msg.GetName())
+		assert.Len(t, msg.GetFixed(), 8)
+
+		assert.GreaterOrEqual(t, len(msg.GetAlphabet()), 2)
+		assert.LessOrEqual(t, len(msg.GetAlphabet()), 4)
+		assert.Regexp(t, `^[ab]+$`, string(msg.GetAlphabet()))
+
+		for _, id := range msg.GetIds() {
+			assert.Len(t, id, uuidLen)
+		}
+	}
+}
+
+func TestBytesULIDSeeded(t *testing.T) {
+	t.Parallel()
+
+	first, second := &test.Bytes{}, &test.Bytes{}
+	require.NoError(t, initProtoFaker(t, withSeed(42)).FakeProto(first))
+	require.NoError(t, initProtoFaker(t, withSeed(42)).FakeProto(second))
+	assert.Equal(t, first.GetUlid(), second.GetUlid())
+}
+
+func TestDecodeBytes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		encoding pb.BytesEncoding
+		str      string
+	}{
+		{pb.BytesEncoding_BYTES_ENCODING_UNSPECIFIED, "hi?"},
+		{pb.BytesEncoding_BYTES_ENCODING_RAW, "hi?"},
+		{pb.BytesEncoding_BYTES_ENCODING_HEX, "68693f"},
+		{pb.BytesEncoding_BYTES_ENCODING_BASE64, "aGk/"},
+		{pb.BytesEncoding_BYTES_ENCODING_BASE64URL, "aGk_"},
+	} {
+		data, err := decodeBytes(tc.encoding, tc.str)
+		require.NoError(t, err, tc.encoding)
+		assert.Equal(t, "hi?", string(data), tc.encoding)
+	}
+
+	data, err := decodeBytes(pb.BytesEncoding_BYTES_ENCODING_BASE64, "aGk=")
+	require.NoError(t, err)
+	assert.Equal(t, "hi", string(data))
+
+	_, err = decodeBytes(pb.BytesEncoding_BYTES_ENCODING_HEX, "abc")
+	require.Error(t, err)
+}
@@ -0,0 +1,273 @@
+package protogofakeit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxEnsureAttempts is the number of times a message is generated in an
+// attempt to satisfy its ensure predicates.
+const maxEnsureAttempts = 100
+
+// celThis is the variable bound to the message in CEL expressions.
+const celThis = "this"
+
+// celProgram is a compiled CEL expression over a message.
+type celProgram struct {
+	expr string
+	prg  cel.Program
+	// refs are the names of the fields of the message the expression
+	// references.
+	refs []string
+}
+
+// newCELEnv returns the environment of CEL expressions over messages of desc,
+// bound to `this`. Alongside the standard library, lists of numbers have a
+// sum() method.
+func newCELEnv(desc protoreflect.MessageDescriptor) (*cel.Env, error) {
+	sum := func(zero ref.Val) cel.OverloadOpt {
+		return cel.UnaryBinding(func(val ref.Val) ref.Val {
+			list, ok := val.(traits.Lister)
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(val)
+			}
+			out := zero
+			for it := list.Iterator(); it.HasNext() == types.True; {
+				adder, ok := out.(traits.Adder)
+				if !ok {
+					return types.MaybeNoSuchOverloadErr(out)
+				}
+				out = adder.Add(it.Next())
+			}
+			return out
+		})
+	}
+	return cel.NewEnv(
+		cel.TypeDescs(desc.ParentFile()),
+		cel.Variable(celThis, cel.ObjectType(string(desc.FullName()))),
+		cel.Function("sum",
+			cel.MemberOverload("list_int_sum",
+				[]*cel.Type{cel.ListType(cel.IntType)}, cel.IntType, sum(types.Int(0))),
+			cel.MemberOverload("list_uint_sum",
+				[]*cel.Type{cel.ListType(cel.UintType)}, cel.UintType, sum(types.Uint(0))),
+			cel.MemberOverload("list_double_sum",
+				[]*cel.Type{cel.ListType(cel.DoubleType)}, cel.DoubleType, sum(types.Double(0))),
+		),
+	)
+}
+
+func compileCEL(env *cel.Env, expr string) (*celProgram, error) {
+	checked, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	prg, err := env.Program(checked)
+	if err != nil {
+		return nil, err
+	}
+	out := &celProgram{expr: expr, prg: prg}
+	ast.PostOrderVisit(checked.NativeRep().Expr(), ast.NewExprVisitor(func(expr ast.Expr) {
+		if expr.Kind() != ast.SelectKind {
+			return
+		}
+		sel := expr.AsSelect()
+		if operand := sel.Operand(); operand.Kind() == ast.IdentKind && operand.AsIdent() == celThis {
+			out.refs = append(out.refs, sel.FieldName())
+		}
+	}))
+	return out, nil
+}
+
+func (p *celProgram) eval(msg protoreflect.Message) (ref.Val, error) {
+	out, _, err := p.prg.Eval(map[string]any{celThis: msg.Interface()})
+	return out, err
+}
+
+// compileCELField compiles the cel expression of the field desc, if any, into
+// plan. The environment is that of the message containing desc.
+func compileCELField(env func() (*cel.Env, error), desc protoreflect.FieldDescriptor, plan *generatorPlan) error {
+	expr := plan.GetCel()
+	if expr == "" {
+		return nil
+	}
+	if desc.IsMap() {
+		return errors.New("cel expressions on map fields are not supported")
+	}
+	celEnv, err := env()
+	if err == nil {
+		plan.cel, err = compileCEL(celEnv, expr)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid cel expression %q: %w", expr, err)
+	}
+	return nil
+}
+
+// compileEnsure compiles the ensure predicates of the message desc.
+func compileEnsure(env func() (*cel.Env, error), desc protoreflect.MessageDescriptor) ([]*celProgram, error) {
+	exprs, _ := proto.GetExtension(desc.Options(), pb.E_Ensure).([]string)
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+	celEnv, err := env()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", desc.FullName(), err)
+	}
+	out := make([]*celProgram, 0, len(exprs))
+	for _, expr := range exprs {
+		prg, err := compileCEL(celEnv, expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid ensure %q: %w", desc.FullName(), expr, err)
+		}
+		out = append(out, prg)
+	}
+	return out, nil
+}
+
+// fakeEnsured fakes msg until it satisfies the ensure predicates of plan,
+// restoring any preexisting fields before each attempt. With stable seeding,
+// each attempt derives its own random stream.
+func (pf *protoFaker) fakeEnsured(sc scope, msg protoreflect.Message, plan *messagePlan) error {
+	orig := proto.Clone(msg.Interface())
+	var failed string
+	for attempt := range maxEnsureAttempts {
+		attemptScope := sc
+		if attempt > 0 {
+			proto.Reset(msg.Interface())
+			proto.Merge(msg.Interface(), orig)
+			attemptScope = sc.derive("ensure#" + strconv.Itoa(attempt))
+		}
+		if err := pf.fakeOnce(attemptScope, msg, plan); err != nil {
+			return err
+		}
+		var err error
+		if failed, err = unsatisfied(plan.ensure, msg); err != nil || failed == "" {
+			return err
+		}
+	}
+	return fmt.Errorf("%s: failed to satisfy ensure %q after %d attempts",
+		msg.Descriptor().FullName(), failed, maxEnsureAttempts)
+}
+
+// unsatisfied returns the first of the predicates that msg does not satisfy,
+// or an empty string if it satisfies all of them.
+func unsatisfied(predicates []*celProgram, msg protoreflect.Message) (string, error) {
+	for _, predicate := range predicates {
+		out, err := predicate.eval(msg)
+		if err != nil {
+			return "", fmt.Errorf("%s: ensure %q: %w", msg.Descriptor().FullName(), predicate.expr, err)
+		}
+		if ok, isBool := out.Value().(bool); !isBool {
+			return "", fmt.Errorf("%s: ensure %q: result is a %s, not a bool",
+				msg.Descriptor().FullName(), predicate.expr, out.Type().TypeName())
+		} else if !ok {
+			return predicate.expr, nil
+		}
+	}
+	return "", nil
+}
+
+// fakeCEL computes the value of the field desc from the cel expression of gen,
+// to be stored in val, a new value of the field.
+func (pf *protoFaker) fakeCEL(
+	sc scope,
+	val protoreflect.Value,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) (protoreflect.Value, error) {
+	out, err := gen.cel.eval(sc.msg)
+	if err != nil {
+		return val, newFieldError(desc, gen, "", err)
+	}
+	if !desc.IsList() {
+		if val, err = celValue(desc, out); err != nil {
+			return val, newFieldError(desc, gen, "", err)
+		}
+		return val, nil
+	}
+	items, ok := out.(traits.Lister)
+	if !ok {
+		return val, newFieldError(desc, gen, "", fmt.Errorf("result is a %s, not a list", out.Type().TypeName()))
+	}
+	list := val.List()
+	for it := items.Iterator(); it.HasNext() == types.True; {
+		item, err := celValue(desc, it.Next())
+		if err != nil {
+			return val, newFieldError(desc, gen, "", err)
+		}
+		list.Append(item)
+	}
+	return val, nil
+}
+
+// celValue converts the CEL value val to a singular value of the field desc.
+//
+//nolint:cyclop
+func celValue(desc protoreflect.FieldDescriptor, val ref.Val) (protoreflect.Value, error) {
+	if types.IsError(val) {
+		return protoreflect.Value{}, fmt.Errorf("%v", val)
+	}
+	switch desc.Kind() {
+	case protoreflect.BoolKind:
+		return celNative[bool](val, protoreflect.ValueOfBool)
+	case protoreflect.EnumKind:
+		return celNative(val, func(n int32) protoreflect.Value {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n))
+		})
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return celNative[int32](val, protoreflect.ValueOfInt32)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return celNative[int64](val, protoreflect.ValueOfInt64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return celNative[uint32](val, protoreflect.ValueOfUint32)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return celNative[uint64](val, protoreflect.ValueOfUint64)
+	case protoreflect.FloatKind:
+		return celNative[float32](val, protoreflect.ValueOfFloat32)
+	case protoreflect.DoubleKind:
+		return celNative[float64](val, protoreflect.ValueOfFloat64)
+	case protoreflect.StringKind:
+		return celNative[string](val, protoreflect.ValueOfString)
+	case protoreflect.BytesKind:
+		return celNative[[]byte](val, protoreflect.ValueOfBytes)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch desc.Message().FullName() {
+		case wktTimestampFQN:
+			return celNative(val, func(ts *timestamppb.Timestamp) protoreflect.Value {
+				return protoreflect.ValueOfMessage(ts.ProtoReflect())
+			})
+		case wktDurationFQN:
+			return celNative(val, func(dur *durationpb.Duration) protoreflect.Value {
+				return protoreflect.ValueOfMessage(dur.ProtoReflect())
+			})
+		}
+		if msg, ok := val.Value().(proto.Message); ok && msg.ProtoReflect().Descriptor().FullName() == desc.Message().FullName() {
+			return protoreflect.ValueOfMessage(msg.ProtoReflect()), nil
+		}
+	default:
+	}
+	return protoreflect.Value{}, fmt.Errorf("result is a %s, not a %s", val.Type().TypeName(), kindName(desc))
+}
+
+// celNative converts val to T, then to a protoreflect.Value with fn.
+func celNative[T any](val ref.Val, fn func(T) protoreflect.Value) (protoreflect.Value, error) {
+	native, err := val.ConvertToNative(reflect.TypeFor[T]())
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	out, _ := native.(T)
+	return fn(out), nil
+}
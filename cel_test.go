@@ -0,0 +1,61 @@
+package protogofakeit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCEL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("computed", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		for range 20 {
+			msg := &test.Invoice{}
+			require.NoError(t, pf.FakeProto(msg))
+
+			assert.True(t, msg.GetEndTime().AsTime().After(msg.GetStartTime().AsTime()))
+			require.NotEmpty(t, msg.GetItems())
+
+			var total float64
+			prices := make([]float64, 0, len(msg.GetItems()))
+			for _, item := range msg.GetItems() {
+				total += item.GetPrice()
+				prices = append(prices, item.GetPrice())
+			}
+			assert.InDelta(t, total, msg.GetTotal(), 1e-6)
+			assert.Equal(t, prices, msg.GetPrices())
+			assert.Equal(t, int64(len(msg.GetItems())), msg.GetCount())
+			assert.Regexp(t, `^.+: \d+ items$`, msg.GetSummary())
+			assert.Equal(t, msg.GetEndTime().AsTime().Add(720*time.Hour), msg.GetDueTime().AsTime())
+		}
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		t.Parallel()
+		first, second := &test.Invoice{}, &test.Invoice{}
+		require.NoError(t, initProtoFaker(t, withSeed(7), WithStableSeeding()).FakeProto(first))
+		require.NoError(t, initProtoFaker(t, withSeed(7), WithStableSeeding()).FakeProto(second))
+		assert.Equal(t, first.GetSummary(), second.GetSummary())
+		assert.True(t, first.GetEndTime().AsTime().After(first.GetStartTime().AsTime()))
+	})
+
+	t.Run("preserves_set_fields", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.Invoice{Customer: "Acme"}
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset)).FakeProto(msg))
+		assert.Equal(t, "Acme", msg.GetCustomer())
+		assert.Regexp(t, `^Acme: \d+ items$`, msg.GetSummary())
+	})
+
+	t.Run("unsatisfiable", func(t *testing.T) {
+		t.Parallel()
+		err := initProtoFaker(t).FakeProto(&test.Unsatisfiable{})
+		require.ErrorContains(t, err, `gofakeit.test.Unsatisfiable: failed to satisfy ensure "this.value < 0u" after 100 attempts`)
+	})
+}
@@ -0,0 +1,127 @@
+package protogofakeit
+
+import (
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestFakeDescriptor(t *testing.T) {
+	t.Parallel()
+
+	desc := (&test.ScalarStaticTags{}).ProtoReflect().Descriptor()
+	msg, err := FakeDescriptor(initProtoFaker(t), desc)
+	require.NoError(t, err)
+
+	out := &test.ScalarStaticTags{}
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(data, out))
+	assert.Equal(t, "foobar", out.GetString_())
+	assert.Equal(t, int32(123), out.GetInt32())
+}
+
+func TestFakeByName(t *testing.T) {
+	t.Parallel()
+
+	files := isolatedFiles(t, test.File_gofakeit_test_wkt_proto)
+
+	t.Run("any", func(t *testing.T) {
+		t.Parallel()
+		msg, err := FakeByName(initProtoFaker(t), "gofakeit.test.WKTAny", files)
+		require.NoError(t, err)
+		assert.NotSame(t, test.File_gofakeit_test_wkt_proto, msg.Descriptor().ParentFile())
+
+		out := &test.WKTAny{}
+		data, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(data, out))
+
+		value := &test.WKTAnyValue{}
+		require.NoError(t, out.GetTag().UnmarshalTo(value))
+		assert.Equal(t, "foobar", value.GetValue())
+		sliceInDefault(t, out.GetList())
+		for _, el := range out.GetList() {
+			_, err = el.UnmarshalNew()
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("configured_files", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithFiles(files))
+		msg, err := FakeByName(pf, "gofakeit.test.WKTAny", nil)
+		require.NoError(t, err)
+		assert.NotSame(t, test.File_gofakeit_test_wkt_proto, msg.Descriptor().ParentFile())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		_, err := FakeByName(initProtoFaker(t), "gofakeit.test.Unknown", files)
+		require.ErrorIs(t, err, protoregistry.NotFound)
+
+		_, err = FakeByName(initProtoFaker(t), "gofakeit.test.WKTAny.tag", files)
+		require.ErrorContains(t, err, "is not a message")
+
+		_, err = FakeByName(initProtoFaker(t), "gofakeit.test.WKTAnyUnknown", files)
+		require.ErrorIs(t, err, protoregistry.NotFound)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		wrapped := struct{ ProtoFaker }{initProtoFaker(t)}
+		_, err := FakeByName(wrapped, "gofakeit.test.WKTAny", files)
+		require.ErrorContains(t, err, "unsupported ProtoFaker implementation")
+	})
+}
+
+func TestProtoFaker_Any(t *testing.T) {
+	t.Parallel()
+
+	msg := &test.WKTAny{}
+	err := initProtoFaker(t).FakeProto(msg)
+	require.NoError(t, err)
+	value := &test.WKTAnyValue{}
+	require.NoError(t, msg.GetTag().UnmarshalTo(value))
+	assert.Equal(t, "foobar", value.GetValue())
+	assert.Equal(t, "type.googleapis.com/gofakeit.test.WKTAnyValue", msg.GetTag().GetTypeUrl())
+
+	msg = &test.WKTAny{}
+	err = initProtoFaker(t, WithMaxDepth(1)).FakeProto(msg)
+	require.NoError(t, err)
+	assert.Nil(t, msg.GetTag())
+
+	err = initProtoFaker(t).FakeProto(&test.WKTAnyUnknown{})
+	require.ErrorIs(t, err, protoregistry.NotFound)
+}
+
+// isolatedFiles copies file and its dependencies into a new registry,
+// producing descriptors distinct from those of the generated Go types.
+func isolatedFiles(tb testing.TB, file protoreflect.FileDescriptor) *protoregistry.Files {
+	tb.Helper()
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := range imports.Len() {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	files, err := protodesc.NewFiles(set)
+	require.NoError(tb, err)
+	return files
+}
@@ -0,0 +1,174 @@
+package protogofakeit
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WithEdgeCaseRate mixes boundary values into generation: with probability
+// rate (between 0 and 1), a field without a tag or template is given an edge
+// case of its kind instead of a uniformly drawn value, such as:
+//
+//   - the minimum and maximum values of integers, zero, and ±1
+//   - ±0, NaN, ±Inf, and the smallest and largest magnitudes of floats
+//   - empty strings, strings of the maximum length (see [WithStringSize]), and
+//     unusual but valid Unicode (NUL, BOM, U+FFFD, U+10FFFF, combining marks,
+//     zero-width and right-to-left characters)
+//   - empty bytes, and all-zero and all-ones bytes of the maximum length
+//   - the first and last enum values, and an undefined value of open enums
+//   - the zero, minimum, and maximum timestamps and durations
+//
+// Likewise, lists and maps are given their minimum or maximum size, which are
+// those of an annotated range, or else empty or of the maximum default size.
+// A rate of 1 generates only edge cases. By default, the rate is 0.
+func WithEdgeCaseRate(rate float64) Option {
+	return optionFunc(func(pf *protoFaker) {
+		if rate < 0 || rate > 1 || math.IsNaN(rate) {
+			pf.addErr(fmt.Errorf("edge case rate must be between 0 and 1, got %v", rate))
+			return
+		}
+		pf.edgeCaseRate = rate
+	})
+}
+
+// edgeCase reports whether the next value should be an edge case.
+func (pf *protoFaker) edgeCase(sc scope) bool {
+	return pf.edgeCaseRate > 0 && sc.faker.Rand.Float64() < pf.edgeCaseRate
+}
+
+// fakeEdgeSize returns either the minimum or maximum size of a list or map.
+func (pf *protoFaker) fakeEdgeSize(sc scope, msg sized, hasSizeOneof bool, def size) int {
+	minimum, maximum := 0, def.max
+	if hasSizeOneof {
+		if rng := msg.GetRange(); rng != nil {
+			minimum, maximum = int(rng.GetMin()), int(rng.GetMax())
+		} else {
+			return int(msg.GetLen())
+		}
+	}
+	if sc.faker.Bool() {
+		return minimum
+	}
+	return maximum
+}
+
+// fakeEdgeCase returns a random boundary value of the kind of desc, or an
+// invalid value if the kind has none. Strings with explicit strings options
+// (strs is non-nil) keep to their charset, only taking the minimum or maximum
+// length.
+//
+//nolint:cyclop
+func (pf *protoFaker) fakeEdgeCase(
+	sc scope,
+	desc protoreflect.FieldDescriptor,
+	strs *stringPlan,
+) protoreflect.Value {
+	if desc.Kind() == protoreflect.MessageKind {
+		switch desc.Message().FullName() {
+		case wktTimestampFQN:
+			ts := pickEdge(sc, []*timestamppb.Timestamp{
+				{},
+				{Seconds: minTimestampSeconds},
+				{Seconds: maxTimestampSeconds, Nanos: 999_999_999},
+			})
+			return protoreflect.ValueOfMessage(ts.ProtoReflect())
+		case wktDurationFQN:
+			dur := pickEdge(sc, []*durationpb.Duration{
+				{},
+				{Nanos: 1},
+				{Seconds: -maxDurationSeconds, Nanos: -999_999_999},
+				{Seconds: maxDurationSeconds, Nanos: 999_999_999},
+			})
+			return protoreflect.ValueOfMessage(dur.ProtoReflect())
+		}
+	}
+
+	switch desc.Kind() {
+	case protoreflect.EnumKind:
+		values := desc.Enum().Values()
+		edges := []protoreflect.EnumNumber{values.Get(0).Number(), values.Get(values.Len() - 1).Number()}
+		if !desc.Enum().IsClosed() {
+			highest := edges[0]
+			for i := range values.Len() {
+				highest = max(highest, values.Get(i).Number())
+			}
+			if highest < math.MaxInt32 {
+				edges = append(edges, highest+1)
+			}
+		}
+		return protoreflect.ValueOfEnum(pickEdge(sc, edges))
+	case protoreflect.Int32Kind,
+		protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(pickEdge(sc, []int32{math.MinInt32, -1, 0, 1, math.MaxInt32}))
+	case protoreflect.Uint32Kind,
+		protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(pickEdge(sc, []uint32{0, 1, math.MaxUint32}))
+	case protoreflect.Int64Kind,
+		protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(pickEdge(sc, []int64{math.MinInt64, -1, 0, 1, math.MaxInt64}))
+	case protoreflect.Uint64Kind,
+		protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(pickEdge(sc, []uint64{0, 1, math.MaxUint64}))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(pickEdge(sc, []float32{
+			0, float32(math.Copysign(0, -1)), float32(math.NaN()),
+			float32(math.Inf(1)), float32(math.Inf(-1)),
+			math.SmallestNonzeroFloat32, math.MaxFloat32, -math.MaxFloat32,
+		}))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(pickEdge(sc, []float64{
+			0, math.Copysign(0, -1), math.NaN(), math.Inf(1), math.Inf(-1),
+			math.SmallestNonzeroFloat64, math.MaxFloat64, -math.MaxFloat64,
+		}))
+	case protoreflect.StringKind:
+		if strs != nil {
+			n := pickEdge(sc, []int{strs.length.min, strs.length.max})
+			return protoreflect.ValueOfString(strs.generateLen(sc.faker, n))
+		}
+		return protoreflect.ValueOfString(pickEdge(sc, []string{
+			"",
+			strings.Repeat("a", pf.stringSize.max),
+			"\x00",                       // NUL
+			"\uFEFF",                     // byte order mark
+			"\uFFFD",                     // replacement character
+			"\U0010FFFF",                 // highest code point
+			"\uD7FF\uE000",               // either side of the surrogates
+			"e\u0301",                    // combining acute accent
+			"\u200B",                     // zero-width space
+			"\u202Eabc",                  // right-to-left override
+			"\U0001F469\u200D\U0001F467", // emoji ZWJ sequence
+		}))
+	case protoreflect.BytesKind:
+		edges := [][]byte{
+			{},
+			make([]byte, pf.bytesSize.max),
+			[]byte(strings.Repeat("\xFF", pf.bytesSize.max)),
+		}
+		return protoreflect.ValueOfBytes(pickEdge(sc, edges))
+	case protoreflect.BoolKind,
+		protoreflect.MessageKind,
+		protoreflect.GroupKind:
+		fallthrough
+	default:
+		return protoreflect.Value{}
+	}
+}
+
+// The bounds of valid google.protobuf.Timestamp and google.protobuf.Duration
+// values.
+const (
+	minTimestampSeconds = -62135596800 // 0001-01-01T00:00:00Z
+	maxTimestampSeconds = 253402300799 // 9999-12-31T23:59:59Z
+	maxDurationSeconds  = 315576000000 // 10,000 years
+)
+
+func pickEdge[T any](sc scope, edges []T) T {
+	return edges[sc.faker.Rand.Intn(len(edges))]
+}
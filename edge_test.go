@@ -0,0 +1,72 @@
+package protogofakeit
+
+import (
+	"math"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithEdgeCaseRate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithEdgeCaseRate(1))
+		nan := false
+		for range 100 {
+			msg := &test.ScalarDefaults{}
+			require.NoError(t, pf.FakeProto(msg))
+			assert.Contains(t, []int32{math.MinInt32, -1, 0, 1, math.MaxInt32}, msg.GetInt32())
+			assert.Contains(t, []uint64{0, 1, math.MaxUint64}, msg.GetUint64())
+			assert.Contains(t, []int{0, defaultMaxSize}, len(msg.GetBytes()))
+			nan = nan || math.IsNaN(msg.GetDouble())
+		}
+		assert.True(t, nan)
+	})
+
+	t.Run("wkt", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.WKTTimestamp{}
+		require.NoError(t, initProtoFaker(t, WithEdgeCaseRate(1)).FakeProto(msg))
+		require.NoError(t, msg.GetDefaultTs().CheckValid())
+		assert.Contains(t, []int64{minTimestampSeconds, 0, maxTimestampSeconds}, msg.GetDefaultTs().GetSeconds())
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithEdgeCaseRate(1))
+		for range 20 {
+			msg := &test.StringsField{}
+			require.NoError(t, pf.FakeProto(msg))
+			assert.Equal(t, 4, utf8.RuneCountInString(msg.GetGreek()))
+			for _, r := range msg.GetGreek() {
+				assert.True(t, unicode.Is(unicode.Greek, r), "%U", r)
+			}
+		}
+	})
+
+	t.Run("sizes", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithEdgeCaseRate(1), WithListSize(2, 3), WithMaxDepth(3))
+		for range 10 {
+			ranged := &test.RepeatedRange{}
+			require.NoError(t, pf.FakeProto(ranged))
+			assert.Contains(t, []int{1, 2}, len(ranged.GetScalars()))
+
+			list := &test.RepeatedDefaults{}
+			require.NoError(t, pf.FakeProto(list))
+			assert.Contains(t, []int{0, 3}, len(list.GetScalars()))
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorContains(t, initProtoFaker(t, WithEdgeCaseRate(1.5)).FakeProto(&test.ScalarDefaults{}),
+			"edge case rate must be between 0 and 1")
+	})
+}
@@ -0,0 +1,98 @@
+package protogofakeit
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var errBinaryString = errors.New("binary embedded messages on string fields require base64")
+
+// fakeEmbedded generates the value of the string or bytes field desc as an
+// encoded fake message, per the Embedded generator of gen. Beyond the maximum
+// depth, the encoded message is empty.
+func (pf *protoFaker) fakeEmbedded(
+	sc scope,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) (protoreflect.Value, error) {
+	emb := gen.GetEmbedded()
+	msgType, err := sc.types.FindMessageByName(protoreflect.FullName(emb.GetType()))
+	if err != nil {
+		return protoreflect.Value{}, newFieldError(desc, gen, "",
+			fmt.Errorf("failed to resolve embedded type %q: %w", emb.GetType(), err))
+	}
+	inner := msgType.New()
+	if sc.depth+1 < pf.maxDepth {
+		if err = pf.fakeMessage(sc.nested(), inner); err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+	data, err := encodeEmbedded(sc.types, emb, desc.Kind(), inner.Interface())
+	if err != nil {
+		return protoreflect.Value{}, newFieldError(desc, gen, "", err)
+	}
+	if desc.Kind() == protoreflect.StringKind {
+		return protoreflect.ValueOfString(string(data)), nil
+	}
+	return protoreflect.ValueOfBytes(data), nil
+}
+
+// encodeEmbedded encodes msg per emb for a field of kind.
+func encodeEmbedded(
+	types protoregistry.MessageTypeResolver,
+	emb *pb.Embedded,
+	kind protoreflect.Kind,
+	msg proto.Message,
+) (data []byte, err error) {
+	// The JSON and text encodings resolve the types of any Any fields, which
+	// may only be known to types.
+	var resolver interface {
+		protoregistry.ExtensionTypeResolver
+		protoregistry.MessageTypeResolver
+	}
+	if res, ok := types.(interface {
+		protoregistry.ExtensionTypeResolver
+		protoregistry.MessageTypeResolver
+	}); ok {
+		resolver = res
+	}
+
+	switch embeddedEncoding(emb, kind) {
+	case pb.EmbeddedEncoding_EMBEDDED_ENCODING_PROTOJSON:
+		data, err = protojson.MarshalOptions{Resolver: resolver}.Marshal(msg)
+	case pb.EmbeddedEncoding_EMBEDDED_ENCODING_PROTOTEXT:
+		data, err = prototext.MarshalOptions{Resolver: resolver}.Marshal(msg)
+	case pb.EmbeddedEncoding_EMBEDDED_ENCODING_UNSPECIFIED,
+		pb.EmbeddedEncoding_EMBEDDED_ENCODING_BINARY:
+		fallthrough
+	default:
+		if kind == protoreflect.StringKind && !emb.GetBase64() {
+			return nil, errBinaryString
+		}
+		data, err = proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	}
+	if err != nil || !emb.GetBase64() {
+		return data, err
+	}
+	return base64.StdEncoding.AppendEncode(nil, data), nil
+}
+
+// embeddedEncoding returns the encoding of emb on a field of kind, resolving
+// the default.
+func embeddedEncoding(emb *pb.Embedded, kind protoreflect.Kind) pb.EmbeddedEncoding {
+	if enc := emb.GetEncoding(); enc != pb.EmbeddedEncoding_EMBEDDED_ENCODING_UNSPECIFIED {
+		return enc
+	}
+	if kind == protoreflect.StringKind && !emb.GetBase64() {
+		return pb.EmbeddedEncoding_EMBEDDED_ENCODING_PROTOJSON
+	}
+	return pb.EmbeddedEncoding_EMBEDDED_ENCODING_BINARY
+}
@@ -0,0 +1,86 @@
+package protogofakeit
+
+import (
+	"encoding/base64"
+	"testing"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEmbedded(t *testing.T) {
+	t.Parallel()
+
+	assertEvent := func(t *testing.T, event *test.Event) {
+		t.Helper()
+		assert.Regexp(t, `^evt_[a-z0-9]{8}$`, event.GetId())
+		assert.GreaterOrEqual(t, event.GetCount(), int32(1))
+		assert.LessOrEqual(t, event.GetCount(), int32(10))
+	}
+
+	t.Run("encodings", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.Envelope{}
+		require.NoError(t, initProtoFaker(t).FakeProto(msg))
+
+		event := &test.Event{}
+		require.NoError(t, proto.Unmarshal(msg.GetPayload(), event))
+		assertEvent(t, event)
+
+		event = &test.Event{}
+		require.NoError(t, protojson.Unmarshal([]byte(msg.GetJsonPayload()), event))
+		assertEvent(t, event)
+
+		event = &test.Event{}
+		require.NoError(t, prototext.Unmarshal([]byte(msg.GetTextPayload()), event))
+		assertEvent(t, event)
+
+		data, err := base64.StdEncoding.DecodeString(msg.GetBase64Payload())
+		require.NoError(t, err)
+		event = &test.Event{}
+		require.NoError(t, proto.Unmarshal(data, event))
+		assertEvent(t, event)
+
+		data, err = base64.StdEncoding.DecodeString(string(msg.GetJsonBytes()))
+		require.NoError(t, err)
+		event = &test.Event{}
+		require.NoError(t, protojson.Unmarshal(data, event))
+		assertEvent(t, event)
+
+		for _, data := range msg.GetEvents() {
+			event = &test.Event{}
+			require.NoError(t, proto.Unmarshal(data, event))
+			assertEvent(t, event)
+		}
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.Envelope{}
+		require.NoError(t, initProtoFaker(t, WithMaxDepth(3)).FakeProto(msg))
+
+		next := &test.Envelope{}
+		require.NoError(t, proto.Unmarshal(msg.GetNext(), next))
+		assert.NotEmpty(t, next.GetPayload())
+		last := &test.Envelope{}
+		require.NoError(t, proto.Unmarshal(next.GetNext(), last))
+		assert.Empty(t, last.GetNext())
+	})
+
+	t.Run("unknown_type", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithRules(&pb.Rule{
+			Name: "^json_payload$",
+			Generate: &pb.Generator{Apply: &pb.Generator_Embedded{
+				Embedded: &pb.Embedded{Type: "gofakeit.test.Missing"},
+			}},
+			Override: true,
+		}))
+		require.ErrorContains(t, pf.FakeProto(&test.Envelope{}), `failed to resolve embedded type "gofakeit.test.Missing"`)
+	})
+}
@@ -0,0 +1,170 @@
+package protogofakeit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrorMode determines how a [ProtoFaker] handles errors generating values.
+type ErrorMode int
+
+const (
+	// FailFast stops generating at the first error, returning it. This is the
+	// default mode.
+	FailFast ErrorMode = iota
+	// Collect skips any values that fail to generate, continuing with the rest
+	// of the message. All errors are returned together, compatible with
+	// [errors.Join] (each can be inspected with [errors.As] or by the
+	// Unwrap() []error method).
+	Collect
+)
+
+// WithErrorMode sets how errors generating values are handled. The default
+// mode is [FailFast].
+func WithErrorMode(mode ErrorMode) Option {
+	return optionFunc(func(pf *protoFaker) { pf.errorMode = mode })
+}
+
+// FieldError describes a failure to generate the value of a field, returned
+// from the methods of a [ProtoFaker]. It can be extracted from a returned
+// error with [errors.As]:
+//
+//	var fieldErr *protogofakeit.FieldError
+//	if errors.As(err, &fieldErr) {
+//		log.Printf("failed to fake %s: %v", fieldErr.Path, fieldErr.Err)
+//	}
+type FieldError struct {
+	// Path is the location of the value within the root message, such as
+	// `pets["Archie"].owner.age`.
+	Path string
+	// Field is the field whose value failed to generate. For map keys and
+	// values, this is the key or value field of the map entry.
+	Field protoreflect.FieldDescriptor
+	// Generator is the generator of the value, if any.
+	Generator *pb.Generator
+	// Value is the raw string produced by the tag or template of Generator,
+	// if one was produced.
+	Value string
+	// Err is the underlying error.
+	Err error
+}
+
+func (err *FieldError) Error() string {
+	if err.Value != "" {
+		return fmt.Sprintf("%s: invalid value %q: %v", err.Path, err.Value, err.Err)
+	}
+	return fmt.Sprintf("%s: %v", err.Path, err.Err)
+}
+
+func (err *FieldError) Unwrap() error {
+	return err.Err
+}
+
+func newFieldError(desc protoreflect.FieldDescriptor, gen *generatorPlan, value string, err error) *FieldError {
+	return &FieldError{
+		Field:     desc,
+		Generator: gen.Generator,
+		Value:     value,
+		Err:       err,
+	}
+}
+
+// fieldErrors aggregates the errors generating multiple values in Collect
+// mode. It is always flat, never containing another fieldErrors.
+type fieldErrors []error
+
+func (errs fieldErrors) Error() string {
+	return errors.Join(errs...).Error()
+}
+
+func (errs fieldErrors) Unwrap() []error {
+	return errs
+}
+
+// err returns the aggregate error, if any.
+func (errs fieldErrors) err() error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return errs
+	}
+}
+
+// collect adds err, if not nil, to errs. It reports whether generation should
+// continue, which is always the case in Collect mode.
+func (pf *protoFaker) collect(errs *fieldErrors, err error) bool {
+	if err == nil {
+		return true
+	}
+	if nested, ok := err.(fieldErrors); ok { //nolint:errorlint // aggregates are never wrapped
+		*errs = append(*errs, nested...)
+	} else {
+		*errs = append(*errs, err)
+	}
+	return pf.errorMode == Collect
+}
+
+// eachError applies fn to each of the aggregated errors in err, or to err
+// itself if not an aggregate.
+func eachError(err error, fn func(err error) error) error {
+	errs, ok := err.(fieldErrors) //nolint:errorlint // aggregates are never wrapped
+	if !ok {
+		return fn(err)
+	}
+	out := make(fieldErrors, len(errs))
+	for i, err := range errs {
+		out[i] = fn(err)
+	}
+	return out
+}
+
+// fieldPathError prefixes the path of any FieldError in err with the name of
+// desc, otherwise wrapping err in a FieldError for desc.
+func fieldPathError(desc protoreflect.FieldDescriptor, gen *generatorPlan, err error) error {
+	return eachError(err, func(err error) error {
+		var fieldErr *FieldError
+		if !errors.As(err, &fieldErr) {
+			err = newFieldError(desc, gen, "", err)
+		}
+		return prefixPathError(string(desc.Name()), err)
+	})
+}
+
+// indexPathError prefixes the path of any FieldError in err with the list
+// index i.
+func indexPathError(i int, err error) error {
+	return prefixPathError("["+strconv.Itoa(i)+"]", err)
+}
+
+// keyPathError prefixes the path of any FieldError in err with the map key.
+func keyPathError(key protoreflect.MapKey, err error) error {
+	if str, ok := key.Interface().(string); ok {
+		return prefixPathError("["+strconv.Quote(str)+"]", err)
+	}
+	return prefixPathError("["+key.String()+"]", err)
+}
+
+// prefixPathError prefixes the path of any FieldError in err, separating
+// field names with a dot.
+func prefixPathError(prefix string, err error) error {
+	return eachError(err, func(err error) error {
+		var fieldErr *FieldError
+		if !errors.As(err, &fieldErr) {
+			return err
+		}
+		if fieldErr.Path != "" && !strings.HasPrefix(fieldErr.Path, "[") {
+			fieldErr.Path = prefix + "." + fieldErr.Path
+		} else {
+			fieldErr.Path = prefix + fieldErr.Path
+		}
+		return err
+	})
+}
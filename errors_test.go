@@ -0,0 +1,110 @@
+package protogofakeit
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestFieldError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("path", func(t *testing.T) {
+		t.Parallel()
+		err := initProtoFaker(t).FakeProto(&test.InvalidUser{})
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, `pets["Archie"].owner.ages[0]`, fieldErr.Path)
+		assert.Equal(t, "gofakeit.test.InvalidOwner.ages", string(fieldErr.Field.FullName()))
+		assert.Equal(t, "abc", fieldErr.Generator.GetTag())
+		assert.Equal(t, "abc", fieldErr.Value)
+		require.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.EqualError(t, err,
+			`pets["Archie"].owner.ages[0]: invalid value "abc": strconv.ParseInt: parsing "abc": invalid syntax`)
+	})
+
+	t.Run("map_key", func(t *testing.T) {
+		t.Parallel()
+		overlay := `fields: {gofakeit.test.MapDefaults.enums: {map: {key: {tag: "abc"}}}}`
+		err := initProtoFaker(t, WithOverlay(strings.NewReader(overlay))).FakeProto(&test.MapDefaults{})
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "enums", fieldErr.Path)
+		assert.Equal(t, "gofakeit.test.MapDefaults.EnumsEntry.key", string(fieldErr.Field.FullName()))
+	})
+
+	t.Run("any", func(t *testing.T) {
+		t.Parallel()
+		err := initProtoFaker(t).FakeProto(&test.WKTAnyUnknown{})
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "value", fieldErr.Path)
+		assert.Equal(t, "gofakeit.test.Unknown", fieldErr.Value)
+		require.ErrorIs(t, err, protoregistry.NotFound)
+	})
+
+	t.Run("message_generator", func(t *testing.T) {
+		t.Parallel()
+		errGen := errors.New("generator failed")
+		pf := initProtoFaker(t, WithMessageGenerator("gofakeit.test.InvalidOwner",
+			func(MessageContext, protoreflect.Message) error { return errGen }))
+		err := pf.FakeProto(&test.InvalidUser{})
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, `pets["Archie"].owner`, fieldErr.Path)
+		require.ErrorIs(t, err, errGen)
+	})
+}
+
+func TestWithErrorMode(t *testing.T) {
+	t.Parallel()
+
+	overlay := `
+fields:
+  gofakeit.test.MapDefaults.scalars: {map: {value: {tag: "maybe"}}}
+  gofakeit.test.MapDefaults.enums: {map: {key: {tag: "abc"}}}
+  gofakeit.test.MapDefaults.recursive: {skip: true}
+`
+	opts := func(opts ...Option) []Option {
+		return append(opts, WithMapSize(2, 2), WithOverlay(strings.NewReader(overlay)))
+	}
+
+	t.Run("fail_fast", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.MapDefaults{}
+		err := initProtoFaker(t, opts()...).FakeProto(msg)
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Contains(t, fieldErr.Path, "scalars[")
+		assert.Empty(t, msg.GetMessages())
+	})
+
+	t.Run("collect", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.MapDefaults{}
+		err := initProtoFaker(t, opts(WithErrorMode(Collect))...).FakeProto(msg)
+		require.Error(t, err)
+
+		joined, ok := err.(interface{ Unwrap() []error }) //nolint:errorlint // testing the aggregate
+		require.True(t, ok)
+		var paths []string
+		for _, err := range joined.Unwrap() {
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr)
+			paths = append(paths, fieldErr.Path)
+		}
+		require.Len(t, paths, 4)
+		assert.Contains(t, paths[0], "scalars[")
+		assert.Contains(t, paths[1], "scalars[")
+		assert.Equal(t, []string{"enums", "enums"}, paths[2:])
+		assert.Len(t, msg.GetMessages(), 2)
+		assert.Empty(t, msg.GetScalars())
+	})
+}
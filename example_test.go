@@ -40,6 +40,16 @@ func Example() {
 	// }
 }
 
+func ExampleFake() {
+	faker := gofakeit.New(3)
+	protoFaker := protogofakeit.New(faker)
+	user := protogofakeit.MustFake[*example.User](protoFaker)
+	fmt.Println(user.GetFirstName(), user.GetLastName())
+
+	// Output:
+	// Philip Casper
+}
+
 func toJSON(msg proto.Message) string {
 	data, _ := protojson.Marshal(msg)
 	buf := &bytes.Buffer{}
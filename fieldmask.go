@@ -0,0 +1,160 @@
+package protogofakeit
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FakeFields populates via pf only the fields of msg named by the paths of
+// mask, leaving all other fields untouched. Messages containing the named
+// fields are created as necessary, but none of their other fields are
+// populated. Each path must name a field of msg, with every segment but the
+// last naming a singular message field. If a path names a field within a
+// oneof, that case is set. An error is returned if a path is invalid or if pf
+// was not created by [New].
+func FakeFields(pf ProtoFaker, msg proto.Message, mask *fieldmaskpb.FieldMask) error {
+	base, err := implementation(pf)
+	if err != nil {
+		return err
+	}
+	return base.fakeMasked(msg, mask, false)
+}
+
+// FakeFieldsExcept populates via pf all fields of msg except those named by
+// the paths of mask, which are left untouched, the inverse of [FakeFields].
+// Messages containing the named fields are populated in the same way. A oneof
+// with a case named by (or containing) a path is left unchanged, other than to
+// populate the messages containing the named fields. pf must be created by
+// [New], or an error is returned.
+func FakeFieldsExcept(pf ProtoFaker, msg proto.Message, mask *fieldmaskpb.FieldMask) error {
+	base, err := implementation(pf)
+	if err != nil {
+		return err
+	}
+	return base.fakeMasked(msg, mask, true)
+}
+
+func (pf *protoFaker) fakeMasked(msg proto.Message, mask *fieldmaskpb.FieldMask, except bool) error {
+	if pf.err != nil {
+		return pf.err
+	}
+	refl := msg.ProtoReflect()
+	tree, err := newMaskTree(refl.Descriptor(), mask.GetPaths())
+	if err != nil {
+		return err
+	}
+	return pf.fakeMaskTree(pf.newScope(pf.types), refl, tree, except)
+}
+
+// maskTree is the set of paths of a field mask, keyed by field name. A nil
+// tree names the entire field.
+type maskTree map[protoreflect.Name]maskTree
+
+func newMaskTree(desc protoreflect.MessageDescriptor, paths []string) (maskTree, error) {
+	root := maskTree{}
+	for _, path := range paths {
+		tree, msgDesc := root, desc
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			field := msgDesc.Fields().ByName(protoreflect.Name(name))
+			switch {
+			case field == nil:
+				return nil, fmt.Errorf("invalid field mask path %q: %s has no field %q", path, msgDesc.FullName(), name)
+			case i == len(names)-1:
+				tree[field.Name()] = nil
+				continue
+			case field.Message() == nil || field.IsList() || field.IsMap():
+				return nil, fmt.Errorf("invalid field mask path %q: %s is not a singular message field", path, field.FullName())
+			}
+			child, exists := tree[field.Name()]
+			if exists && child == nil {
+				// an ancestor already names the entire field
+				break
+			}
+			if !exists {
+				child = maskTree{}
+				tree[field.Name()] = child
+			}
+			tree, msgDesc = child, field.Message()
+		}
+	}
+	return root, nil
+}
+
+// fakeMaskTree populates the fields of msg named by tree, or if except is
+// true, all other fields.
+func (pf *protoFaker) fakeMaskTree(sc scope, msg protoreflect.Message, tree maskTree, except bool) error {
+	plan := pf.plan(msg.Descriptor())
+	if plan.err != nil {
+		return plan.err
+	}
+
+	var errs fieldErrors
+	visit := func(field *fieldPlan) bool {
+		child, named := tree[field.desc.Name()]
+		switch {
+		case named && child != nil:
+			return pf.collect(&errs, pf.fakeMaskAncestor(sc, msg, field, child, except))
+		case named == except:
+			return true
+		default:
+			return pf.collect(&errs, pf.fakeField(sc, msg, field))
+		}
+	}
+
+	// Fields are visited in the order they are generated: oneofs first, then
+	// the remaining fields, each after the fields it references.
+	for _, oneof := range plan.oneofs {
+		if except && !tree.touches(oneof) {
+			if !pf.collect(&errs, pf.fakeOneof(sc, msg, oneof)) {
+				return errs.err()
+			}
+			continue
+		}
+		for _, field := range oneof.fields {
+			if _, named := tree[field.desc.Name()]; named && !visit(field) {
+				return errs.err()
+			}
+		}
+	}
+	for _, field := range plan.fields {
+		if !visit(field) {
+			break
+		}
+	}
+	return errs.err()
+}
+
+// fakeMaskAncestor populates the fields named by tree within the message
+// field on msg, creating it if necessary.
+func (pf *protoFaker) fakeMaskAncestor(
+	sc scope,
+	msg protoreflect.Message,
+	field *fieldPlan,
+	tree maskTree,
+	except bool,
+) error {
+	sc = sc.field(string(field.desc.Name()))
+	if sc.depth+1 >= pf.maxDepth {
+		return nil
+	}
+	err := pf.fakeMaskTree(sc.nested(), msg.Mutable(field.desc).Message(), tree, except)
+	if err != nil {
+		return prefixPathError(string(field.desc.Name()), err)
+	}
+	return nil
+}
+
+// touches reports whether any field of oneof is named by the tree.
+func (tree maskTree) touches(oneof *oneofPlan) bool {
+	for _, field := range oneof.fields {
+		if _, ok := tree[field.desc.Name()]; ok {
+			return true
+		}
+	}
+	return false
+}
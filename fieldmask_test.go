@@ -0,0 +1,109 @@
+package protogofakeit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestFakeFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.ScalarDefaults{Int64: 7}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"string", "bytes"}}
+		require.NoError(t, FakeFields(initProtoFaker(t), msg, mask))
+		assert.NotEmpty(t, msg.GetString_())
+		assert.NotEmpty(t, msg.GetBytes())
+		assert.Equal(t, int64(7), msg.GetInt64())
+		assert.Zero(t, msg.GetInt32())
+		assert.Zero(t, msg.GetDouble())
+	})
+
+	t.Run("ancestors", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.CustomContainer{}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"singular.value", "option.other"}}
+		require.NoError(t, FakeFields(initProtoFaker(t), msg, mask))
+		assert.NotEmpty(t, msg.GetSingular().GetValue())
+		assert.Zero(t, msg.GetSingular().GetOther())
+		assert.NotNil(t, msg.GetOption())
+		assert.Empty(t, msg.GetOption().GetValue())
+		assert.Empty(t, msg.GetList())
+		assert.Empty(t, msg.GetMap())
+	})
+
+	t.Run("whole_field", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.CustomContainer{}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"singular.value", "singular", "list"}}
+		require.NoError(t, FakeFields(initProtoFaker(t), msg, mask))
+		assert.NotEmpty(t, msg.GetSingular().GetValue())
+		assert.NotEmpty(t, msg.GetList())
+		assert.Nil(t, msg.GetOption())
+	})
+
+	t.Run("references", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.Order{}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"first_name", "customer"}}
+		require.NoError(t, FakeFields(initProtoFaker(t), msg, mask))
+		assert.NotEmpty(t, msg.GetFirstName())
+		assert.Equal(t, msg.GetCustomer().GetFirstName(), msg.GetFirstName())
+		assert.Empty(t, msg.GetEmail())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		err := FakeFields(pf, &test.CustomContainer{}, &fieldmaskpb.FieldMask{Paths: []string{"missing"}})
+		require.ErrorContains(t, err, `has no field "missing"`)
+		err = FakeFields(pf, &test.CustomContainer{}, &fieldmaskpb.FieldMask{Paths: []string{"list.value"}})
+		require.ErrorContains(t, err, "not a singular message field")
+		err = FakeFields(pf, &test.CustomContainer{}, &fieldmaskpb.FieldMask{Paths: []string{"singular.value.foo"}})
+		require.ErrorContains(t, err, "not a singular message field")
+	})
+}
+
+func TestFakeFieldsExcept(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.ScalarDefaults{Int64: 7}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"int64", "string"}}
+		require.NoError(t, FakeFieldsExcept(initProtoFaker(t), msg, mask))
+		assert.Empty(t, msg.GetString_())
+		assert.Equal(t, int64(7), msg.GetInt64())
+		assert.NotEmpty(t, msg.GetBytes())
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.CustomContainer{Choice: &test.CustomContainer_Option{Option: &test.Custom{Value: "keep"}}}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"singular.value", "option.value"}}
+		require.NoError(t, FakeFieldsExcept(initProtoFaker(t, WithMaxDepth(2)), msg, mask))
+		assert.Empty(t, msg.GetSingular().GetValue())
+		assert.NotZero(t, msg.GetSingular().GetOther())
+		assert.Equal(t, "keep", msg.GetOption().GetValue())
+		assert.NotZero(t, msg.GetOption().GetOther())
+		assert.NotEmpty(t, msg.GetList())
+	})
+
+	t.Run("references", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.Order{}
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"tags"}}
+		require.NoError(t, FakeFieldsExcept(initProtoFaker(t), msg, mask))
+		assert.NotEmpty(t, msg.GetFirstName())
+		assert.Equal(t, msg.GetCustomer().GetFirstName(), msg.GetFirstName())
+		assert.Equal(t, strings.ToLower(msg.GetFirstName())+"@example.com", msg.GetEmail())
+		assert.Empty(t, msg.GetTags())
+		assert.Empty(t, msg.GetTagsCopy())
+	})
+}
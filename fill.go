@@ -0,0 +1,143 @@
+package protogofakeit
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FillMode determines how a [ProtoFaker] handles fields that are already
+// populated on a message.
+type FillMode int
+
+const (
+	// Overwrite replaces the value of every field, clearing any oneof that
+	// is randomly left unset. This is the default mode.
+	Overwrite FillMode = iota
+	// OnlyUnset preserves populated fields, only generating values for those
+	// that are unset. Populated message fields (including the elements of
+	// lists and values of maps) are recursed into to fill their unset fields.
+	// Populated lists and maps are otherwise left as-is, and a oneof with a
+	// populated case is not changed.
+	//
+	// Fields without presence (such as proto3 scalars that are not optional)
+	// are considered unset if they hold the zero value.
+	OnlyUnset
+	// OnlyUnsetAppend is the same as OnlyUnset, but also appends generated
+	// elements to populated lists and adds generated entries to populated
+	// maps. Existing map entries are never replaced.
+	OnlyUnsetAppend
+)
+
+// WithFillMode sets how fields already populated on a message are handled.
+// The default mode is [Overwrite].
+func WithFillMode(mode FillMode) Option {
+	return optionFunc(func(pf *protoFaker) { pf.fillMode = mode })
+}
+
+// fillField fills the gaps of the populated field on msg according to the
+// configured FillMode.
+func (pf *protoFaker) fillField(
+	sc scope,
+	msg protoreflect.Message,
+	field *fieldPlan,
+) error {
+	desc, gen := field.desc, field.gen
+	switch {
+	case desc.IsMap():
+		mapVal := msg.Mutable(desc).Map()
+		var errs fieldErrors
+		if isFillable(desc.MapValue()) {
+			for i, key := range sortedMapKeys(mapVal) {
+				err := pf.fillMessage(sc.index(i), mapVal.Get(key).Message())
+				if err != nil && !pf.collect(&errs, keyPathError(key, err)) {
+					return errs.err()
+				}
+			}
+		}
+		if pf.fillMode != OnlyUnsetAppend {
+			return errs.err()
+		}
+		generated := msg.NewField(desc).Map()
+		pf.collect(&errs, pf.fakeMap(sc, desc, gen, generated))
+		generated.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+			if !mapVal.Has(key) {
+				mapVal.Set(key, val)
+			}
+			return true
+		})
+		return errs.err()
+	case desc.IsList():
+		list := msg.Mutable(desc).List()
+		var errs fieldErrors
+		if isFillable(desc) {
+			for i := range list.Len() {
+				err := pf.fillMessage(sc.index(i), list.Get(i).Message())
+				if err != nil && !pf.collect(&errs, indexPathError(i, err)) {
+					return errs.err()
+				}
+			}
+		}
+		if pf.fillMode != OnlyUnsetAppend {
+			return errs.err()
+		}
+		generated := msg.NewField(desc).List()
+		pf.collect(&errs, pf.fakeList(sc, desc, gen, generated))
+		for i := range generated.Len() {
+			list.Append(generated.Get(i))
+		}
+		return errs.err()
+	case isFillable(desc):
+		return pf.fillMessage(sc, msg.Mutable(desc).Message())
+	default:
+		return nil
+	}
+}
+
+// fillMessage fills the unset fields of the nested message msg, unless the
+// maximum depth is reached.
+func (pf *protoFaker) fillMessage(sc scope, msg protoreflect.Message) error {
+	if sc.depth+1 >= pf.maxDepth {
+		return nil
+	}
+	return pf.fakeMessage(sc.nested(), msg)
+}
+
+// isFillable reports whether desc is a message whose fields can be filled,
+// excluding well-known types generated as a single value.
+func isFillable(desc protoreflect.FieldDescriptor) bool {
+	if desc.Kind() != protoreflect.MessageKind && desc.Kind() != protoreflect.GroupKind {
+		return false
+	}
+	switch desc.Message().FullName() {
+	case wktTimestampFQN, wktDurationFQN, wktAnyFQN:
+		return false
+	default:
+		return true
+	}
+}
+
+// sortedMapKeys returns the keys of mapVal in ascending order, so that maps
+// are filled deterministically.
+func sortedMapKeys(mapVal protoreflect.Map) []protoreflect.MapKey {
+	keys := make([]protoreflect.MapKey, 0, mapVal.Len())
+	mapVal.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	slices.SortFunc(keys, func(a, b protoreflect.MapKey) int {
+		switch a.Interface().(type) {
+		case bool:
+			return cmp.Compare(a.String(), b.String())
+		case int32, int64:
+			return cmp.Compare(a.Int(), b.Int())
+		case uint32, uint64:
+			return cmp.Compare(a.Uint(), b.Uint())
+		default:
+			return strings.Compare(a.String(), b.String())
+		}
+	})
+	return keys
+}
@@ -0,0 +1,118 @@
+package protogofakeit
+
+import (
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWithFillMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.ScalarDefaults{String_: "keep", Int32: 7}
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset)).FakeProto(msg))
+		assert.Equal(t, "keep", msg.GetString_())
+		assert.Equal(t, int32(7), msg.GetInt32())
+		assert.NotEmpty(t, msg.GetBytes())
+
+		require.NoError(t, initProtoFaker(t).FakeProto(msg))
+		assert.NotEqual(t, "keep", msg.GetString_())
+	})
+
+	t.Run("messages", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.SelfRecursive{Foo: "keep", Recurse: &test.SelfRecursive{}}
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset)).FakeProto(msg))
+		assert.Equal(t, "keep", msg.GetFoo())
+		assert.NotEmpty(t, msg.GetRecurse().GetFoo())
+	})
+
+	t.Run("oneofs", func(t *testing.T) {
+		t.Parallel()
+		for range 10 {
+			msg := &test.OneOf{Fields: &test.OneOf_Scalar{Scalar: "keep"}}
+			require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset)).FakeProto(msg))
+			assert.Equal(t, "keep", msg.GetScalar())
+
+			msg = &test.OneOf{Fields: &test.OneOf_Message{Message: &test.OneOf{}}}
+			require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset), WithMaxDepth(2)).FakeProto(msg))
+			assert.NotNil(t, msg.GetMessage())
+		}
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		t.Parallel()
+		newMsg := func() *test.RepeatedDefaults {
+			return &test.RepeatedDefaults{
+				Scalars:  []string{"keep"},
+				Messages: []*test.RepeatedMsg{{Foo: "keep"}, {}},
+			}
+		}
+
+		msg := newMsg()
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset)).FakeProto(msg))
+		assert.Equal(t, []string{"keep"}, msg.GetScalars())
+		require.Len(t, msg.GetMessages(), 2)
+		assert.Equal(t, "keep", msg.GetMessages()[0].GetFoo())
+		assert.NotEmpty(t, msg.GetMessages()[1].GetFoo())
+		assert.NotEmpty(t, msg.GetEnums())
+
+		msg = newMsg()
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnsetAppend)).FakeProto(msg))
+		assert.Equal(t, "keep", msg.GetScalars()[0])
+		sliceIn(t, msg.GetScalars(), defaultMinSize+1, defaultMaxSize+1)
+		assert.Equal(t, "keep", msg.GetMessages()[0].GetFoo())
+		sliceIn(t, msg.GetMessages(), defaultMinSize+2, defaultMaxSize+2)
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		t.Parallel()
+		newMsg := func() *test.MapDefaults {
+			return &test.MapDefaults{
+				Scalars: map[string]bool{"keep": true},
+				Messages: map[string]*test.MapMsg{
+					"a": {Foo: "keep"},
+					"b": {},
+					"c": {},
+				},
+			}
+		}
+
+		msg := newMsg()
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset)).FakeProto(msg))
+		assert.Equal(t, map[string]bool{"keep": true}, msg.GetScalars())
+		require.Len(t, msg.GetMessages(), 3)
+		assert.Equal(t, "keep", msg.GetMessages()["a"].GetFoo())
+		assert.NotEmpty(t, msg.GetMessages()["b"].GetFoo())
+
+		msg = newMsg()
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnsetAppend)).FakeProto(msg))
+		assert.True(t, msg.GetScalars()["keep"])
+		mapIn(t, msg.GetScalars(), defaultMinSize+1, defaultMaxSize+1)
+		assert.Equal(t, "keep", msg.GetMessages()["a"].GetFoo())
+		assert.Greater(t, len(msg.GetMessages()), 3)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		t.Parallel()
+		a := &test.MapDefaults{Messages: map[string]*test.MapMsg{"a": {}, "b": {}, "c": {}, "d": {}}}
+		b := proto.Clone(a)
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset), withSeed(42)).FakeProto(a))
+		require.NoError(t, initProtoFaker(t, WithFillMode(OnlyUnset), withSeed(42)).FakeProto(b))
+		assert.True(t, proto.Equal(a, b))
+	})
+
+	t.Run("stable_map_values", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.MapDefaults{Messages: map[string]*test.MapMsg{"a": {}, "b": {}}}
+		pf := initProtoFaker(t, WithFillMode(OnlyUnset), WithStableSeeding())
+		require.NoError(t, pf.FakeProto(msg))
+		assert.NotEmpty(t, msg.GetMessages()["a"].GetFoo())
+		assert.NotEqual(t, msg.GetMessages()["a"].GetFoo(), msg.GetMessages()["b"].GetFoo())
+	})
+}
@@ -21,6 +21,350 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BytesEncoding int32
+
+const (
+	// BYTES_ENCODING_UNSPECIFIED uses the generated string as-is.
+	BytesEncoding_BYTES_ENCODING_UNSPECIFIED BytesEncoding = 0
+	BytesEncoding_BYTES_ENCODING_RAW         BytesEncoding = 1
+	BytesEncoding_BYTES_ENCODING_HEX         BytesEncoding = 2
+	// BYTES_ENCODING_BASE64 is standard base64, with or without padding.
+	BytesEncoding_BYTES_ENCODING_BASE64 BytesEncoding = 3
+	// BYTES_ENCODING_BASE64URL is URL-safe base64, with or without padding.
+	BytesEncoding_BYTES_ENCODING_BASE64URL BytesEncoding = 4
+)
+
+// Enum value maps for BytesEncoding.
+var (
+	BytesEncoding_name = map[int32]string{
+		0: "BYTES_ENCODING_UNSPECIFIED",
+		1: "BYTES_ENCODING_RAW",
+		2: "BYTES_ENCODING_HEX",
+		3: "BYTES_ENCODING_BASE64",
+		4: "BYTES_ENCODING_BASE64URL",
+	}
+	BytesEncoding_value = map[string]int32{
+		"BYTES_ENCODING_UNSPECIFIED": 0,
+		"BYTES_ENCODING_RAW":         1,
+		"BYTES_ENCODING_HEX":         2,
+		"BYTES_ENCODING_BASE64":      3,
+		"BYTES_ENCODING_BASE64URL":   4,
+	}
+)
+
+func (x BytesEncoding) Enum() *BytesEncoding {
+	p := new(BytesEncoding)
+	*p = x
+	return p
+}
+
+func (x BytesEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BytesEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_gofakeit_proto_enumTypes[0].Descriptor()
+}
+
+func (BytesEncoding) Type() protoreflect.EnumType {
+	return &file_gofakeit_gofakeit_proto_enumTypes[0]
+}
+
+func (x BytesEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BytesEncoding.Descriptor instead.
+func (BytesEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{0}
+}
+
+type BytesShape int32
+
+const (
+	BytesShape_BYTES_SHAPE_UNSPECIFIED BytesShape = 0
+	// BYTES_SHAPE_UUID is a 16-byte, version 4 UUID.
+	BytesShape_BYTES_SHAPE_UUID BytesShape = 1
+	// BYTES_SHAPE_ULID is a 16-byte ULID, with a random timestamp between
+	// 2015-01-01 and 2025-01-01.
+	BytesShape_BYTES_SHAPE_ULID BytesShape = 2
+)
+
+// Enum value maps for BytesShape.
+var (
+	BytesShape_name = map[int32]string{
+		0: "BYTES_SHAPE_UNSPECIFIED",
+		1: "BYTES_SHAPE_UUID",
+		2: "BYTES_SHAPE_ULID",
+	}
+	BytesShape_value = map[string]int32{
+		"BYTES_SHAPE_UNSPECIFIED": 0,
+		"BYTES_SHAPE_UUID":        1,
+		"BYTES_SHAPE_ULID":        2,
+	}
+)
+
+func (x BytesShape) Enum() *BytesShape {
+	p := new(BytesShape)
+	*p = x
+	return p
+}
+
+func (x BytesShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BytesShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_gofakeit_proto_enumTypes[1].Descriptor()
+}
+
+func (BytesShape) Type() protoreflect.EnumType {
+	return &file_gofakeit_gofakeit_proto_enumTypes[1]
+}
+
+func (x BytesShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BytesShape.Descriptor instead.
+func (BytesShape) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{1}
+}
+
+type EmbeddedEncoding int32
+
+const (
+	EmbeddedEncoding_EMBEDDED_ENCODING_UNSPECIFIED EmbeddedEncoding = 0
+	EmbeddedEncoding_EMBEDDED_ENCODING_BINARY      EmbeddedEncoding = 1
+	EmbeddedEncoding_EMBEDDED_ENCODING_PROTOJSON   EmbeddedEncoding = 2
+	EmbeddedEncoding_EMBEDDED_ENCODING_PROTOTEXT   EmbeddedEncoding = 3
+)
+
+// Enum value maps for EmbeddedEncoding.
+var (
+	EmbeddedEncoding_name = map[int32]string{
+		0: "EMBEDDED_ENCODING_UNSPECIFIED",
+		1: "EMBEDDED_ENCODING_BINARY",
+		2: "EMBEDDED_ENCODING_PROTOJSON",
+		3: "EMBEDDED_ENCODING_PROTOTEXT",
+	}
+	EmbeddedEncoding_value = map[string]int32{
+		"EMBEDDED_ENCODING_UNSPECIFIED": 0,
+		"EMBEDDED_ENCODING_BINARY":      1,
+		"EMBEDDED_ENCODING_PROTOJSON":   2,
+		"EMBEDDED_ENCODING_PROTOTEXT":   3,
+	}
+)
+
+func (x EmbeddedEncoding) Enum() *EmbeddedEncoding {
+	p := new(EmbeddedEncoding)
+	*p = x
+	return p
+}
+
+func (x EmbeddedEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmbeddedEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_gofakeit_proto_enumTypes[2].Descriptor()
+}
+
+func (EmbeddedEncoding) Type() protoreflect.EnumType {
+	return &file_gofakeit_gofakeit_proto_enumTypes[2]
+}
+
+func (x EmbeddedEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmbeddedEncoding.Descriptor instead.
+func (EmbeddedEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{2}
+}
+
+type Charset int32
+
+const (
+	// CHARSET_UNSPECIFIED uses ASCII letters.
+	Charset_CHARSET_UNSPECIFIED   Charset = 0
+	Charset_CHARSET_ASCII_LETTERS Charset = 1
+	Charset_CHARSET_ALPHANUMERIC  Charset = 2
+	// CHARSET_PRINTABLE is printable ASCII, including punctuation and spaces.
+	Charset_CHARSET_PRINTABLE Charset = 3
+	// CHARSET_BMP is any graphic character of the Basic Multilingual Plane,
+	// excluding combining marks.
+	Charset_CHARSET_BMP   Charset = 4
+	Charset_CHARSET_EMOJI Charset = 5
+	// CHARSET_RTL is right-to-left Hebrew and Arabic letters.
+	Charset_CHARSET_RTL Charset = 6
+	// CHARSET_COMBINING is Latin letters each followed by one to three
+	// combining diacritical marks.
+	Charset_CHARSET_COMBINING Charset = 7
+	// CHARSET_SCRIPTS is the letters of the Unicode scripts named by scripts.
+	Charset_CHARSET_SCRIPTS Charset = 8
+)
+
+// Enum value maps for Charset.
+var (
+	Charset_name = map[int32]string{
+		0: "CHARSET_UNSPECIFIED",
+		1: "CHARSET_ASCII_LETTERS",
+		2: "CHARSET_ALPHANUMERIC",
+		3: "CHARSET_PRINTABLE",
+		4: "CHARSET_BMP",
+		5: "CHARSET_EMOJI",
+		6: "CHARSET_RTL",
+		7: "CHARSET_COMBINING",
+		8: "CHARSET_SCRIPTS",
+	}
+	Charset_value = map[string]int32{
+		"CHARSET_UNSPECIFIED":   0,
+		"CHARSET_ASCII_LETTERS": 1,
+		"CHARSET_ALPHANUMERIC":  2,
+		"CHARSET_PRINTABLE":     3,
+		"CHARSET_BMP":           4,
+		"CHARSET_EMOJI":         5,
+		"CHARSET_RTL":           6,
+		"CHARSET_COMBINING":     7,
+		"CHARSET_SCRIPTS":       8,
+	}
+)
+
+func (x Charset) Enum() *Charset {
+	p := new(Charset)
+	*p = x
+	return p
+}
+
+func (x Charset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Charset) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_gofakeit_proto_enumTypes[3].Descriptor()
+}
+
+func (Charset) Type() protoreflect.EnumType {
+	return &file_gofakeit_gofakeit_proto_enumTypes[3]
+}
+
+func (x Charset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Charset.Descriptor instead.
+func (Charset) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{3}
+}
+
+type LengthUnit int32
+
+const (
+	// LENGTH_UNIT_UNSPECIFIED measures lengths in runes.
+	LengthUnit_LENGTH_UNIT_UNSPECIFIED LengthUnit = 0
+	LengthUnit_LENGTH_UNIT_RUNES       LengthUnit = 1
+	// LENGTH_UNIT_BYTES measures lengths in UTF-8 bytes. Generated strings are
+	// at most the length, as multi-byte characters may not fit exactly.
+	LengthUnit_LENGTH_UNIT_BYTES     LengthUnit = 2
+	LengthUnit_LENGTH_UNIT_GRAPHEMES LengthUnit = 3
+)
+
+// Enum value maps for LengthUnit.
+var (
+	LengthUnit_name = map[int32]string{
+		0: "LENGTH_UNIT_UNSPECIFIED",
+		1: "LENGTH_UNIT_RUNES",
+		2: "LENGTH_UNIT_BYTES",
+		3: "LENGTH_UNIT_GRAPHEMES",
+	}
+	LengthUnit_value = map[string]int32{
+		"LENGTH_UNIT_UNSPECIFIED": 0,
+		"LENGTH_UNIT_RUNES":       1,
+		"LENGTH_UNIT_BYTES":       2,
+		"LENGTH_UNIT_GRAPHEMES":   3,
+	}
+)
+
+func (x LengthUnit) Enum() *LengthUnit {
+	p := new(LengthUnit)
+	*p = x
+	return p
+}
+
+func (x LengthUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LengthUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_gofakeit_proto_enumTypes[4].Descriptor()
+}
+
+func (LengthUnit) Type() protoreflect.EnumType {
+	return &file_gofakeit_gofakeit_proto_enumTypes[4]
+}
+
+func (x LengthUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LengthUnit.Descriptor instead.
+func (LengthUnit) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{4}
+}
+
+type Cardinality int32
+
+const (
+	Cardinality_CARDINALITY_UNSPECIFIED Cardinality = 0
+	Cardinality_CARDINALITY_SINGULAR    Cardinality = 1
+	Cardinality_CARDINALITY_REPEATED    Cardinality = 2
+	Cardinality_CARDINALITY_MAP         Cardinality = 3
+)
+
+// Enum value maps for Cardinality.
+var (
+	Cardinality_name = map[int32]string{
+		0: "CARDINALITY_UNSPECIFIED",
+		1: "CARDINALITY_SINGULAR",
+		2: "CARDINALITY_REPEATED",
+		3: "CARDINALITY_MAP",
+	}
+	Cardinality_value = map[string]int32{
+		"CARDINALITY_UNSPECIFIED": 0,
+		"CARDINALITY_SINGULAR":    1,
+		"CARDINALITY_REPEATED":    2,
+		"CARDINALITY_MAP":         3,
+	}
+)
+
+func (x Cardinality) Enum() *Cardinality {
+	p := new(Cardinality)
+	*p = x
+	return p
+}
+
+func (x Cardinality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Cardinality) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_gofakeit_proto_enumTypes[5].Descriptor()
+}
+
+func (Cardinality) Type() protoreflect.EnumType {
+	return &file_gofakeit_gofakeit_proto_enumTypes[5]
+}
+
+func (x Cardinality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Cardinality.Descriptor instead.
+func (Cardinality) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{5}
+}
+
 type Generator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,6 +377,12 @@ type Generator struct {
 	//	*Generator_Template
 	//	*Generator_Repeated
 	//	*Generator_Map
+	//	*Generator_Strings
+	//	*Generator_Pattern
+	//	*Generator_Bytes
+	//	*Generator_Embedded
+	//	*Generator_CopyFrom
+	//	*Generator_Cel
 	Apply isGenerator_Apply `protobuf_oneof:"apply"`
 }
 
@@ -110,6 +460,48 @@ func (x *Generator) GetMap() *Map {
 	return nil
 }
 
+func (x *Generator) GetStrings() *Strings {
+	if x, ok := x.GetApply().(*Generator_Strings); ok {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *Generator) GetPattern() string {
+	if x, ok := x.GetApply().(*Generator_Pattern); ok {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Generator) GetBytes() *Bytes {
+	if x, ok := x.GetApply().(*Generator_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Generator) GetEmbedded() *Embedded {
+	if x, ok := x.GetApply().(*Generator_Embedded); ok {
+		return x.Embedded
+	}
+	return nil
+}
+
+func (x *Generator) GetCopyFrom() string {
+	if x, ok := x.GetApply().(*Generator_CopyFrom); ok {
+		return x.CopyFrom
+	}
+	return ""
+}
+
+func (x *Generator) GetCel() string {
+	if x, ok := x.GetApply().(*Generator_Cel); ok {
+		return x.Cel
+	}
+	return ""
+}
+
 type isGenerator_Apply interface {
 	isGenerator_Apply()
 }
@@ -123,6 +515,9 @@ type Generator_Tag struct {
 }
 
 type Generator_Template struct {
+	// template is a Go template rendered with gofakeit's functions. Fields of
+	// the message can be read with `.Field` and totaled with `.Sum`, given
+	// their paths, in which case they are generated first, as with copy_from.
 	Template string `protobuf:"bytes,3,opt,name=template,proto3,oneof"`
 }
 
@@ -134,6 +529,43 @@ type Generator_Map struct {
 	Map *Map `protobuf:"bytes,5,opt,name=map,proto3,oneof"`
 }
 
+type Generator_Strings struct {
+	Strings *Strings `protobuf:"bytes,6,opt,name=strings,proto3,oneof"`
+}
+
+type Generator_Pattern struct {
+	// pattern is an RE2 regular expression matched by generated values of
+	// string or bytes fields.
+	Pattern string `protobuf:"bytes,7,opt,name=pattern,proto3,oneof"`
+}
+
+type Generator_Bytes struct {
+	Bytes *Bytes `protobuf:"bytes,8,opt,name=bytes,proto3,oneof"`
+}
+
+type Generator_Embedded struct {
+	// embedded generates a string or bytes field holding an encoded fake
+	// message.
+	Embedded *Embedded `protobuf:"bytes,9,opt,name=embedded,proto3,oneof"`
+}
+
+type Generator_CopyFrom struct {
+	// copy_from copies the value of another field of the message, given by
+	// its dot-separated path through nested message fields (e.g.,
+	// "customer.email"). The field is generated after the one it copies.
+	// Oneofs are generated before other fields, so fields within a oneof may
+	// only reference fields of preceding oneofs.
+	CopyFrom string `protobuf:"bytes,10,opt,name=copy_from,json=copyFrom,proto3,oneof"`
+}
+
+type Generator_Cel struct {
+	// cel computes the value from a CEL expression over the message, bound to
+	// `this` (e.g., "this.items.map(i, i.price).sum()"). The field is
+	// generated after the fields the expression references, which are
+	// restricted within oneofs as with copy_from.
+	Cel string `protobuf:"bytes,11,opt,name=cel,proto3,oneof"`
+}
+
 func (*Generator_Skip) isGenerator_Apply() {}
 
 func (*Generator_Tag) isGenerator_Apply() {}
@@ -144,6 +576,18 @@ func (*Generator_Repeated) isGenerator_Apply() {}
 
 func (*Generator_Map) isGenerator_Apply() {}
 
+func (*Generator_Strings) isGenerator_Apply() {}
+
+func (*Generator_Pattern) isGenerator_Apply() {}
+
+func (*Generator_Bytes) isGenerator_Apply() {}
+
+func (*Generator_Embedded) isGenerator_Apply() {}
+
+func (*Generator_CopyFrom) isGenerator_Apply() {}
+
+func (*Generator_Cel) isGenerator_Apply() {}
+
 type Repeated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -385,120 +829,906 @@ func (x *Range) GetMax() uint32 {
 	return 0
 }
 
-var file_gofakeit_gofakeit_proto_extTypes = []protoimpl.ExtensionInfo{
-	{
-		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
-		ExtensionType: (*Generator)(nil),
-		Field:         112233,
-		Name:          "gofakeit.generate",
-		Tag:           "bytes,112233,opt,name=generate",
-		Filename:      "gofakeit/gofakeit.proto",
-	},
+// Bytes configures the generation of bytes fields.
+type Bytes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// encoding decodes the output of the tag, template, or pattern into bytes.
+	// By default, the output is used as-is.
+	Encoding BytesEncoding `protobuf:"varint,1,opt,name=encoding,proto3,enum=gofakeit.BytesEncoding" json:"encoding,omitempty"`
+	// Types that are assignable to Source:
+	//
+	//	*Bytes_Tag
+	//	*Bytes_Template
+	//	*Bytes_Pattern
+	//	*Bytes_Shape
+	//	*Bytes_Random
+	Source isBytes_Source `protobuf_oneof:"source"`
 }
 
-// Extension fields to descriptorpb.FieldOptions.
-var (
-	// optional gofakeit.Generator generate = 112233;
-	E_Generate = &file_gofakeit_gofakeit_proto_extTypes[0]
-)
+func (x *Bytes) Reset() {
+	*x = Bytes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_gofakeit_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var File_gofakeit_gofakeit_proto protoreflect.FileDescriptor
+func (x *Bytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_gofakeit_gofakeit_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b,
-	0x65, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x67, 0x6f, 0x66, 0x61, 0x6b,
-	0x65, 0x69, 0x74, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x61, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a,
-	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a,
-	0x03, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x66,
-	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70,
-	0x42, 0x07, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x22, 0x7e, 0x0a, 0x08, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b,
-	0x65, 0x69, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x03, 0x4d, 0x61,
-	0x70, 0x12, 0x12, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
-	0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f,
-	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x06, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x2b, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6d, 0x61, 0x78, 0x3a, 0x50, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0xe9, 0xec, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b,
-	0x65, 0x69, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*Bytes) ProtoMessage() {}
+
+func (x *Bytes) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_gofakeit_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_gofakeit_gofakeit_proto_rawDescOnce sync.Once
-	file_gofakeit_gofakeit_proto_rawDescData = file_gofakeit_gofakeit_proto_rawDesc
-)
+// Deprecated: Use Bytes.ProtoReflect.Descriptor instead.
+func (*Bytes) Descriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{4}
+}
 
-func file_gofakeit_gofakeit_proto_rawDescGZIP() []byte {
-	file_gofakeit_gofakeit_proto_rawDescOnce.Do(func() {
-		file_gofakeit_gofakeit_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_gofakeit_proto_rawDescData)
-	})
-	return file_gofakeit_gofakeit_proto_rawDescData
+func (x *Bytes) GetEncoding() BytesEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return BytesEncoding_BYTES_ENCODING_UNSPECIFIED
 }
 
-var file_gofakeit_gofakeit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_gofakeit_gofakeit_proto_goTypes = []interface{}{
-	(*Generator)(nil),                 // 0: gofakeit.Generator
-	(*Repeated)(nil),                  // 1: gofakeit.Repeated
-	(*Map)(nil),                       // 2: gofakeit.Map
-	(*Range)(nil),                     // 3: gofakeit.Range
-	(*descriptorpb.FieldOptions)(nil), // 4: google.protobuf.FieldOptions
+func (m *Bytes) GetSource() isBytes_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
 }
-var file_gofakeit_gofakeit_proto_depIdxs = []int32{
-	1, // 0: gofakeit.Generator.repeated:type_name -> gofakeit.Repeated
-	2, // 1: gofakeit.Generator.map:type_name -> gofakeit.Map
-	3, // 2: gofakeit.Repeated.range:type_name -> gofakeit.Range
-	0, // 3: gofakeit.Repeated.element:type_name -> gofakeit.Generator
-	3, // 4: gofakeit.Map.range:type_name -> gofakeit.Range
-	0, // 5: gofakeit.Map.key:type_name -> gofakeit.Generator
-	0, // 6: gofakeit.Map.value:type_name -> gofakeit.Generator
-	4, // 7: gofakeit.generate:extendee -> google.protobuf.FieldOptions
-	0, // 8: gofakeit.generate:type_name -> gofakeit.Generator
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	8, // [8:9] is the sub-list for extension type_name
-	7, // [7:8] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+
+func (x *Bytes) GetTag() string {
+	if x, ok := x.GetSource().(*Bytes_Tag); ok {
+		return x.Tag
+	}
+	return ""
 }
 
-func init() { file_gofakeit_gofakeit_proto_init() }
-func file_gofakeit_gofakeit_proto_init() {
-	if File_gofakeit_gofakeit_proto != nil {
-		return
+func (x *Bytes) GetTemplate() string {
+	if x, ok := x.GetSource().(*Bytes_Template); ok {
+		return x.Template
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_gofakeit_gofakeit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Generator); i {
-			case 0:
-				return &v.state
+	return ""
+}
+
+func (x *Bytes) GetPattern() string {
+	if x, ok := x.GetSource().(*Bytes_Pattern); ok {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Bytes) GetShape() BytesShape {
+	if x, ok := x.GetSource().(*Bytes_Shape); ok {
+		return x.Shape
+	}
+	return BytesShape_BYTES_SHAPE_UNSPECIFIED
+}
+
+func (x *Bytes) GetRandom() *RandomBytes {
+	if x, ok := x.GetSource().(*Bytes_Random); ok {
+		return x.Random
+	}
+	return nil
+}
+
+type isBytes_Source interface {
+	isBytes_Source()
+}
+
+type Bytes_Tag struct {
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3,oneof"`
+}
+
+type Bytes_Template struct {
+	Template string `protobuf:"bytes,3,opt,name=template,proto3,oneof"`
+}
+
+type Bytes_Pattern struct {
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3,oneof"`
+}
+
+type Bytes_Shape struct {
+	// shape generates bytes of a well-known binary format.
+	Shape BytesShape `protobuf:"varint,5,opt,name=shape,proto3,enum=gofakeit.BytesShape,oneof"`
+}
+
+type Bytes_Random struct {
+	// random generates random bytes.
+	Random *RandomBytes `protobuf:"bytes,6,opt,name=random,proto3,oneof"`
+}
+
+func (*Bytes_Tag) isBytes_Source() {}
+
+func (*Bytes_Template) isBytes_Source() {}
+
+func (*Bytes_Pattern) isBytes_Source() {}
+
+func (*Bytes_Shape) isBytes_Source() {}
+
+func (*Bytes_Random) isBytes_Source() {}
+
+type RandomBytes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Size:
+	//
+	//	*RandomBytes_Len
+	//	*RandomBytes_Range
+	Size isRandomBytes_Size `protobuf_oneof:"size"`
+	// alphabet restricts the generated bytes to those it contains. By default,
+	// any byte may be generated.
+	Alphabet []byte `protobuf:"bytes,3,opt,name=alphabet,proto3" json:"alphabet,omitempty"`
+}
+
+func (x *RandomBytes) Reset() {
+	*x = RandomBytes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_gofakeit_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomBytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomBytes) ProtoMessage() {}
+
+func (x *RandomBytes) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_gofakeit_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomBytes.ProtoReflect.Descriptor instead.
+func (*RandomBytes) Descriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *RandomBytes) GetSize() isRandomBytes_Size {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+func (x *RandomBytes) GetLen() uint32 {
+	if x, ok := x.GetSize().(*RandomBytes_Len); ok {
+		return x.Len
+	}
+	return 0
+}
+
+func (x *RandomBytes) GetRange() *Range {
+	if x, ok := x.GetSize().(*RandomBytes_Range); ok {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *RandomBytes) GetAlphabet() []byte {
+	if x != nil {
+		return x.Alphabet
+	}
+	return nil
+}
+
+type isRandomBytes_Size interface {
+	isRandomBytes_Size()
+}
+
+type RandomBytes_Len struct {
+	Len uint32 `protobuf:"varint,1,opt,name=len,proto3,oneof"`
+}
+
+type RandomBytes_Range struct {
+	Range *Range `protobuf:"bytes,2,opt,name=range,proto3,oneof"`
+}
+
+func (*RandomBytes_Len) isRandomBytes_Size() {}
+
+func (*RandomBytes_Range) isRandomBytes_Size() {}
+
+// Embedded configures a string or bytes field holding an encoded message,
+// which is faked with its own annotations.
+type Embedded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the fully-qualified name of the message (e.g., "acme.v1.Event").
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// encoding defaults to protojson for string fields without base64, and to
+	// binary otherwise.
+	Encoding EmbeddedEncoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=gofakeit.EmbeddedEncoding" json:"encoding,omitempty"`
+	// base64 further encodes the message with standard, padded base64. It is
+	// required for binary messages on string fields.
+	Base64 bool `protobuf:"varint,3,opt,name=base64,proto3" json:"base64,omitempty"`
+}
+
+func (x *Embedded) Reset() {
+	*x = Embedded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_gofakeit_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Embedded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Embedded) ProtoMessage() {}
+
+func (x *Embedded) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_gofakeit_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Embedded.ProtoReflect.Descriptor instead.
+func (*Embedded) Descriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Embedded) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Embedded) GetEncoding() EmbeddedEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return EmbeddedEncoding_EMBEDDED_ENCODING_UNSPECIFIED
+}
+
+func (x *Embedded) GetBase64() bool {
+	if x != nil {
+		return x.Base64
+	}
+	return false
+}
+
+// Strings configures the characters and length of generated strings.
+type Strings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Charset Charset `protobuf:"varint,1,opt,name=charset,proto3,enum=gofakeit.Charset" json:"charset,omitempty"`
+	// scripts names the Unicode scripts (e.g., "Greek", "Han") characters are
+	// drawn from with CHARSET_SCRIPTS.
+	Scripts []string `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// unit determines how the length is measured.
+	Unit LengthUnit `protobuf:"varint,3,opt,name=unit,proto3,enum=gofakeit.LengthUnit" json:"unit,omitempty"`
+	// length is the range of lengths of generated strings. If unset, the
+	// default string size is used.
+	Length *Range `protobuf:"bytes,4,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *Strings) Reset() {
+	*x = Strings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_gofakeit_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strings) ProtoMessage() {}
+
+func (x *Strings) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_gofakeit_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strings.ProtoReflect.Descriptor instead.
+func (*Strings) Descriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Strings) GetCharset() Charset {
+	if x != nil {
+		return x.Charset
+	}
+	return Charset_CHARSET_UNSPECIFIED
+}
+
+func (x *Strings) GetScripts() []string {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+func (x *Strings) GetUnit() LengthUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return LengthUnit_LENGTH_UNIT_UNSPECIFIED
+}
+
+func (x *Strings) GetLength() *Range {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+// Overlay configures generators for fields outside of their proto source,
+// such as fields of dependencies that cannot be annotated directly.
+type Overlay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fields maps fully-qualified field names (e.g., "acme.v1.User.email") or
+	// glob patterns (e.g., "acme.*.email") to the generator for the matching
+	// fields.
+	Fields map[string]*Generator `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// rules apply generators to all fields matching their conditions.
+	Rules []*Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *Overlay) Reset() {
+	*x = Overlay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_gofakeit_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Overlay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Overlay) ProtoMessage() {}
+
+func (x *Overlay) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_gofakeit_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Overlay.ProtoReflect.Descriptor instead.
+func (*Overlay) Descriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Overlay) GetFields() map[string]*Generator {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Overlay) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Rule applies a generator to all fields matching every one of its set
+// conditions. Rules are evaluated in order, with the first match applied.
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is an RE2 regular expression matched against the field's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kinds restricts matches to fields of these kinds (e.g., "string",
+	// "int64", "enum", "message").
+	Kinds []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// cardinality restricts matches to singular, repeated, or map fields.
+	Cardinality Cardinality `protobuf:"varint,3,opt,name=cardinality,proto3,enum=gofakeit.Cardinality" json:"cardinality,omitempty"`
+	// message is a glob pattern matched against the fully-qualified name of
+	// the field's containing message (e.g., "*.Audit").
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// package is a glob pattern matched against the package of the file
+	// declaring the field (e.g., "acme.billing.*").
+	Package string `protobuf:"bytes,5,opt,name=package,proto3" json:"package,omitempty"`
+	// file is a glob pattern matched against the path of the file declaring
+	// the field (e.g., "acme/billing/*.proto").
+	File string `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`
+	// type is a glob pattern matched against the fully-qualified name of the
+	// field's message or enum type (e.g., "google.protobuf.Timestamp").
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// options restricts matches to fields with all of these options set,
+	// identified by name for standard options (e.g., "deprecated") or by
+	// fully-qualified name for extensions (e.g., "acme.sensitive").
+	Options []string `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"`
+	// generate is applied to matching fields.
+	Generate *Generator `protobuf:"bytes,9,opt,name=generate,proto3" json:"generate,omitempty"`
+	// override applies the rule even if the field has an explicit generator
+	// from an annotation or overlay. By default, explicit generators take
+	// precedence over rules.
+	Override bool `protobuf:"varint,10,opt,name=override,proto3" json:"override,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_gofakeit_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_gofakeit_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_gofakeit_gofakeit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *Rule) GetCardinality() Cardinality {
+	if x != nil {
+		return x.Cardinality
+	}
+	return Cardinality_CARDINALITY_UNSPECIFIED
+}
+
+func (x *Rule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Rule) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *Rule) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Rule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Rule) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Rule) GetGenerate() *Generator {
+	if x != nil {
+		return x.Generate
+	}
+	return nil
+}
+
+func (x *Rule) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+var file_gofakeit_gofakeit_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*Generator)(nil),
+		Field:         112233,
+		Name:          "gofakeit.generate",
+		Tag:           "bytes,112233,opt,name=generate",
+		Filename:      "gofakeit/gofakeit.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*Strings)(nil),
+		Field:         112233,
+		Name:          "gofakeit.strings",
+		Tag:           "bytes,112233,opt,name=strings",
+		Filename:      "gofakeit/gofakeit.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         112234,
+		Name:          "gofakeit.ensure",
+		Tag:           "bytes,112234,rep,name=ensure",
+		Filename:      "gofakeit/gofakeit.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional gofakeit.Generator generate = 112233;
+	E_Generate = &file_gofakeit_gofakeit_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// strings configures the generation of the message's string fields without
+	// their own tag, template, or strings options.
+	//
+	// optional gofakeit.Strings strings = 112233;
+	E_Strings = &file_gofakeit_gofakeit_proto_extTypes[1]
+	// ensure lists CEL predicates over the message, bound to `this` (e.g.,
+	// "this.end_time > this.start_time"), that generated messages must satisfy.
+	// Messages are regenerated until all hold, up to a bounded number of
+	// attempts.
+	//
+	// repeated string ensure = 112234;
+	E_Ensure = &file_gofakeit_gofakeit_proto_extTypes[2]
+)
+
+var File_gofakeit_gofakeit_proto protoreflect.FileDescriptor
+
+var file_gofakeit_gofakeit_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b,
+	0x65, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x67, 0x6f, 0x66, 0x61, 0x6b,
+	0x65, 0x69, 0x74, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x03, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x03, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70,
+	0x12, 0x2d, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1a, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x70,
+	0x79, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x03, 0x63, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x63, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x61, 0x70, 0x70,
+	0x6c, 0x79, 0x22, 0x7e, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x03, 0x6c,
+	0x65, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x03, 0x6c, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x27,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x2b, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xf3,
+	0x01, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x1c, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2c, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x68, 0x61, 0x70, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x6e, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x6e, 0x0a, 0x08, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61,
+	0x73, 0x65, 0x36, 0x34, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x72, 0x73, 0x65, 0x74, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74,
+	0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xb6, 0x01, 0x0a, 0x07, 0x4f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x24, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x1a, 0x4e, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xac, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
+	0x69, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x2a, 0x98, 0x01, 0x0a, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x48,
+	0x45, 0x58, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x55, 0x52, 0x4c, 0x10, 0x04, 0x2a, 0x55, 0x0a,
+	0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x42,
+	0x59, 0x54, 0x45, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x59, 0x54, 0x45,
+	0x53, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x55, 0x4c,
+	0x49, 0x44, 0x10, 0x02, 0x2a, 0x95, 0x01, 0x0a, 0x10, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4d, 0x42,
+	0x45, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x4d, 0x42, 0x45, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x4d,
+	0x42, 0x45, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x45,
+	0x4d, 0x42, 0x45, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x03, 0x2a, 0xcf, 0x01, 0x0a,
+	0x07, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x52,
+	0x53, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x41, 0x53, 0x43,
+	0x49, 0x49, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x4e, 0x55, 0x4d,
+	0x45, 0x52, 0x49, 0x43, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45,
+	0x54, 0x5f, 0x50, 0x52, 0x49, 0x4e, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x42, 0x4d, 0x50, 0x10, 0x04, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x45, 0x4d, 0x4f, 0x4a, 0x49, 0x10,
+	0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x54, 0x4c,
+	0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4f,
+	0x4d, 0x42, 0x49, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41,
+	0x52, 0x53, 0x45, 0x54, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x53, 0x10, 0x08, 0x2a, 0x72,
+	0x0a, 0x0a, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x17,
+	0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x4e,
+	0x47, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x45, 0x53, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f,
+	0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x45, 0x4e, 0x47, 0x54,
+	0x48, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x45, 0x4d, 0x45, 0x53,
+	0x10, 0x03, 0x2a, 0x73, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x41, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x49,
+	0x4e, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x52, 0x44,
+	0x49, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x41, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x4d, 0x41, 0x50, 0x10, 0x03, 0x3a, 0x50, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xe9, 0xec, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x4e, 0x0a, 0x07, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe9, 0xec, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x39, 0x0a, 0x06, 0x65, 0x6e, 0x73,
+	0x75, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xea, 0xec, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gofakeit_gofakeit_proto_rawDescOnce sync.Once
+	file_gofakeit_gofakeit_proto_rawDescData = file_gofakeit_gofakeit_proto_rawDesc
+)
+
+func file_gofakeit_gofakeit_proto_rawDescGZIP() []byte {
+	file_gofakeit_gofakeit_proto_rawDescOnce.Do(func() {
+		file_gofakeit_gofakeit_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_gofakeit_proto_rawDescData)
+	})
+	return file_gofakeit_gofakeit_proto_rawDescData
+}
+
+var file_gofakeit_gofakeit_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_gofakeit_gofakeit_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_gofakeit_gofakeit_proto_goTypes = []interface{}{
+	(BytesEncoding)(0),                  // 0: gofakeit.BytesEncoding
+	(BytesShape)(0),                     // 1: gofakeit.BytesShape
+	(EmbeddedEncoding)(0),               // 2: gofakeit.EmbeddedEncoding
+	(Charset)(0),                        // 3: gofakeit.Charset
+	(LengthUnit)(0),                     // 4: gofakeit.LengthUnit
+	(Cardinality)(0),                    // 5: gofakeit.Cardinality
+	(*Generator)(nil),                   // 6: gofakeit.Generator
+	(*Repeated)(nil),                    // 7: gofakeit.Repeated
+	(*Map)(nil),                         // 8: gofakeit.Map
+	(*Range)(nil),                       // 9: gofakeit.Range
+	(*Bytes)(nil),                       // 10: gofakeit.Bytes
+	(*RandomBytes)(nil),                 // 11: gofakeit.RandomBytes
+	(*Embedded)(nil),                    // 12: gofakeit.Embedded
+	(*Strings)(nil),                     // 13: gofakeit.Strings
+	(*Overlay)(nil),                     // 14: gofakeit.Overlay
+	(*Rule)(nil),                        // 15: gofakeit.Rule
+	nil,                                 // 16: gofakeit.Overlay.FieldsEntry
+	(*descriptorpb.FieldOptions)(nil),   // 17: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil), // 18: google.protobuf.MessageOptions
+}
+var file_gofakeit_gofakeit_proto_depIdxs = []int32{
+	7,  // 0: gofakeit.Generator.repeated:type_name -> gofakeit.Repeated
+	8,  // 1: gofakeit.Generator.map:type_name -> gofakeit.Map
+	13, // 2: gofakeit.Generator.strings:type_name -> gofakeit.Strings
+	10, // 3: gofakeit.Generator.bytes:type_name -> gofakeit.Bytes
+	12, // 4: gofakeit.Generator.embedded:type_name -> gofakeit.Embedded
+	9,  // 5: gofakeit.Repeated.range:type_name -> gofakeit.Range
+	6,  // 6: gofakeit.Repeated.element:type_name -> gofakeit.Generator
+	9,  // 7: gofakeit.Map.range:type_name -> gofakeit.Range
+	6,  // 8: gofakeit.Map.key:type_name -> gofakeit.Generator
+	6,  // 9: gofakeit.Map.value:type_name -> gofakeit.Generator
+	0,  // 10: gofakeit.Bytes.encoding:type_name -> gofakeit.BytesEncoding
+	1,  // 11: gofakeit.Bytes.shape:type_name -> gofakeit.BytesShape
+	11, // 12: gofakeit.Bytes.random:type_name -> gofakeit.RandomBytes
+	9,  // 13: gofakeit.RandomBytes.range:type_name -> gofakeit.Range
+	2,  // 14: gofakeit.Embedded.encoding:type_name -> gofakeit.EmbeddedEncoding
+	3,  // 15: gofakeit.Strings.charset:type_name -> gofakeit.Charset
+	4,  // 16: gofakeit.Strings.unit:type_name -> gofakeit.LengthUnit
+	9,  // 17: gofakeit.Strings.length:type_name -> gofakeit.Range
+	16, // 18: gofakeit.Overlay.fields:type_name -> gofakeit.Overlay.FieldsEntry
+	15, // 19: gofakeit.Overlay.rules:type_name -> gofakeit.Rule
+	5,  // 20: gofakeit.Rule.cardinality:type_name -> gofakeit.Cardinality
+	6,  // 21: gofakeit.Rule.generate:type_name -> gofakeit.Generator
+	6,  // 22: gofakeit.Overlay.FieldsEntry.value:type_name -> gofakeit.Generator
+	17, // 23: gofakeit.generate:extendee -> google.protobuf.FieldOptions
+	18, // 24: gofakeit.strings:extendee -> google.protobuf.MessageOptions
+	18, // 25: gofakeit.ensure:extendee -> google.protobuf.MessageOptions
+	6,  // 26: gofakeit.generate:type_name -> gofakeit.Generator
+	13, // 27: gofakeit.strings:type_name -> gofakeit.Strings
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	26, // [26:28] is the sub-list for extension type_name
+	23, // [23:26] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_gofakeit_proto_init() }
+func file_gofakeit_gofakeit_proto_init() {
+	if File_gofakeit_gofakeit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_gofakeit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Generator); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -543,6 +1773,78 @@ func file_gofakeit_gofakeit_proto_init() {
 				return nil
 			}
 		}
+		file_gofakeit_gofakeit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bytes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_gofakeit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomBytes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_gofakeit_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Embedded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_gofakeit_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_gofakeit_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Overlay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_gofakeit_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_gofakeit_gofakeit_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Generator_Skip)(nil),
@@ -550,6 +1852,12 @@ func file_gofakeit_gofakeit_proto_init() {
 		(*Generator_Template)(nil),
 		(*Generator_Repeated)(nil),
 		(*Generator_Map)(nil),
+		(*Generator_Strings)(nil),
+		(*Generator_Pattern)(nil),
+		(*Generator_Bytes)(nil),
+		(*Generator_Embedded)(nil),
+		(*Generator_CopyFrom)(nil),
+		(*Generator_Cel)(nil),
 	}
 	file_gofakeit_gofakeit_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*Repeated_Len)(nil),
@@ -559,18 +1867,30 @@ func file_gofakeit_gofakeit_proto_init() {
 		(*Map_Len)(nil),
 		(*Map_Range)(nil),
 	}
+	file_gofakeit_gofakeit_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*Bytes_Tag)(nil),
+		(*Bytes_Template)(nil),
+		(*Bytes_Pattern)(nil),
+		(*Bytes_Shape)(nil),
+		(*Bytes_Random)(nil),
+	}
+	file_gofakeit_gofakeit_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*RandomBytes_Len)(nil),
+		(*RandomBytes_Range)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gofakeit_gofakeit_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
-			NumExtensions: 1,
+			NumEnums:      6,
+			NumMessages:   11,
+			NumExtensions: 3,
 			NumServices:   0,
 		},
 		GoTypes:           file_gofakeit_gofakeit_proto_goTypes,
 		DependencyIndexes: file_gofakeit_gofakeit_proto_depIdxs,
+		EnumInfos:         file_gofakeit_gofakeit_proto_enumTypes,
 		MessageInfos:      file_gofakeit_gofakeit_proto_msgTypes,
 		ExtensionInfos:    file_gofakeit_gofakeit_proto_extTypes,
 	}.Build()
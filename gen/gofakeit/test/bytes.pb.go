@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/bytes.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Bytes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid     []byte   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Ulid     []byte   `protobuf:"bytes,2,opt,name=ulid,proto3" json:"ulid,omitempty"`
+	Constant []byte   `protobuf:"bytes,3,opt,name=constant,proto3" json:"constant,omitempty"`
+	Sha256   []byte   `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Token    []byte   `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Name     []byte   `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Fixed    []byte   `protobuf:"bytes,7,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Alphabet []byte   `protobuf:"bytes,8,opt,name=alphabet,proto3" json:"alphabet,omitempty"`
+	Ids      [][]byte `protobuf:"bytes,9,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *Bytes) Reset() {
+	*x = Bytes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_bytes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bytes) ProtoMessage() {}
+
+func (x *Bytes) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_bytes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bytes.ProtoReflect.Descriptor instead.
+func (*Bytes) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_bytes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bytes) GetUuid() []byte {
+	if x != nil {
+		return x.Uuid
+	}
+	return nil
+}
+
+func (x *Bytes) GetUlid() []byte {
+	if x != nil {
+		return x.Ulid
+	}
+	return nil
+}
+
+func (x *Bytes) GetConstant() []byte {
+	if x != nil {
+		return x.Constant
+	}
+	return nil
+}
+
+func (x *Bytes) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *Bytes) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Bytes) GetName() []byte {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *Bytes) GetFixed() []byte {
+	if x != nil {
+		return x.Fixed
+	}
+	return nil
+}
+
+func (x *Bytes) GetAlphabet() []byte {
+	if x != nil {
+		return x.Alphabet
+	}
+	return nil
+}
+
+func (x *Bytes) GetIds() [][]byte {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+var File_gofakeit_test_bytes_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_bytes_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x08, 0xca, 0xe6, 0x36,
+	0x04, 0x42, 0x02, 0x28, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x04, 0x75,
+	0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x08, 0xca, 0xe6, 0x36, 0x04, 0x42,
+	0x02, 0x28, 0x02, 0x52, 0x04, 0x75, 0x6c, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x12, 0xca, 0xe6, 0x36,
+	0x0e, 0x42, 0x0c, 0x08, 0x02, 0x12, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x65, 0x65, 0x66, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x16, 0xca, 0xe6, 0x36, 0x12, 0x42,
+	0x10, 0x08, 0x02, 0x22, 0x0c, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x5d, 0x7b, 0x36, 0x34,
+	0x7d, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1b, 0xca, 0xe6, 0x36, 0x17, 0x42, 0x15,
+	0x08, 0x04, 0x22, 0x11, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x13, 0xca, 0xe6, 0x36, 0x0f,
+	0x42, 0x0d, 0x12, 0x0b, 0x7b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0a, 0xca, 0xe6, 0x36, 0x06, 0x42, 0x04, 0x32, 0x02, 0x08, 0x08,
+	0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x62, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x12, 0xca, 0xe6, 0x36, 0x0e, 0x42,
+	0x0c, 0x32, 0x0a, 0x1a, 0x02, 0x61, 0x62, 0x12, 0x04, 0x08, 0x02, 0x10, 0x04, 0x52, 0x08, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0c, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x22, 0x06, 0x0a, 0x04, 0x42, 0x02,
+	0x28, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gofakeit_test_bytes_proto_rawDescOnce sync.Once
+	file_gofakeit_test_bytes_proto_rawDescData = file_gofakeit_test_bytes_proto_rawDesc
+)
+
+func file_gofakeit_test_bytes_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_bytes_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_bytes_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_bytes_proto_rawDescData)
+	})
+	return file_gofakeit_test_bytes_proto_rawDescData
+}
+
+var file_gofakeit_test_bytes_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gofakeit_test_bytes_proto_goTypes = []interface{}{
+	(*Bytes)(nil), // 0: gofakeit.test.Bytes
+}
+var file_gofakeit_test_bytes_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_bytes_proto_init() }
+func file_gofakeit_test_bytes_proto_init() {
+	if File_gofakeit_test_bytes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_bytes_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bytes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_bytes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_bytes_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_bytes_proto_depIdxs,
+		MessageInfos:      file_gofakeit_test_bytes_proto_msgTypes,
+	}.Build()
+	File_gofakeit_test_bytes_proto = out.File
+	file_gofakeit_test_bytes_proto_rawDesc = nil
+	file_gofakeit_test_bytes_proto_goTypes = nil
+	file_gofakeit_test_bytes_proto_depIdxs = nil
+}
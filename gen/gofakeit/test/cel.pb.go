@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/cel.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     float64                `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	Count     int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Summary   string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Prices    []float64              `protobuf:"fixed64,4,rep,packed,name=prices,proto3" json:"prices,omitempty"`
+	DueTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	Customer  string                 `protobuf:"bytes,6,opt,name=customer,proto3" json:"customer,omitempty"`
+	Items     []*InvoiceItem         `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_cel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_cel_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_cel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invoice) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Invoice) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Invoice) GetPrices() []float64 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *Invoice) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
+func (x *Invoice) GetCustomer() string {
+	if x != nil {
+		return x.Customer
+	}
+	return ""
+}
+
+func (x *Invoice) GetItems() []*InvoiceItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Invoice) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Invoice) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type InvoiceItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *InvoiceItem) Reset() {
+	*x = InvoiceItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_cel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceItem) ProtoMessage() {}
+
+func (x *InvoiceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_cel_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceItem.ProtoReflect.Descriptor instead.
+func (*InvoiceItem) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_cel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InvoiceItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Unsatisfiable has an ensure predicate that can never hold.
+type Unsatisfiable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value uint32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Unsatisfiable) Reset() {
+	*x = Unsatisfiable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_cel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Unsatisfiable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unsatisfiable) ProtoMessage() {}
+
+func (x *Unsatisfiable) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_cel_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unsatisfiable.ProtoReflect.Descriptor instead.
+func (*Unsatisfiable) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_cel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Unsatisfiable) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_gofakeit_test_cel_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_cel_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x63, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x6f, 0x66, 0x61, 0x6b,
+	0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
+	0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xef, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x3c,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x26, 0xca,
+	0xe6, 0x36, 0x22, 0x5a, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e,
+	0x6d, 0x61, 0x70, 0x28, 0x69, 0x2c, 0x20, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x29, 0x2e,
+	0x73, 0x75, 0x6d, 0x28, 0x29, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xca, 0xe6, 0x36,
+	0x12, 0x5a, 0x10, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x29, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xca, 0xe6, 0x36,
+	0x36, 0x5a, 0x34, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x20, 0x2b, 0x20, 0x27, 0x3a, 0x20, 0x27, 0x20, 0x2b, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x29, 0x20, 0x2b, 0x20, 0x27,
+	0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x27, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x38, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01,
+	0x42, 0x20, 0xca, 0xe6, 0x36, 0x1c, 0x5a, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x2e, 0x6d, 0x61, 0x70, 0x28, 0x69, 0x2c, 0x20, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x29, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x08, 0x64, 0x75,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x26, 0xca, 0xe6, 0x36, 0x22, 0x5a, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x2b, 0x20,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x27, 0x37, 0x32, 0x30, 0x68, 0x27, 0x29,
+	0x52, 0x07, 0x64, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xca, 0xe6, 0x36,
+	0x08, 0x12, 0x06, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x3b, 0xd2, 0xe6, 0x36, 0x1f, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x3e, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0xd2, 0xe6, 0x36, 0x14,
+	0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x29,
+	0x20, 0x3e, 0x20, 0x30, 0x22, 0x38, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x13, 0xca, 0xe6, 0x36, 0x0f, 0x12, 0x0d, 0x7b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x3a, 0x31, 0x2c, 0x31, 0x30, 0x30, 0x7d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3a,
+	0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x13, 0xd2, 0xe6, 0x36, 0x0f, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x3c, 0x20, 0x30, 0x75, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gofakeit_test_cel_proto_rawDescOnce sync.Once
+	file_gofakeit_test_cel_proto_rawDescData = file_gofakeit_test_cel_proto_rawDesc
+)
+
+func file_gofakeit_test_cel_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_cel_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_cel_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_cel_proto_rawDescData)
+	})
+	return file_gofakeit_test_cel_proto_rawDescData
+}
+
+var file_gofakeit_test_cel_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_gofakeit_test_cel_proto_goTypes = []interface{}{
+	(*Invoice)(nil),               // 0: gofakeit.test.Invoice
+	(*InvoiceItem)(nil),           // 1: gofakeit.test.InvoiceItem
+	(*Unsatisfiable)(nil),         // 2: gofakeit.test.Unsatisfiable
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_gofakeit_test_cel_proto_depIdxs = []int32{
+	3, // 0: gofakeit.test.Invoice.due_time:type_name -> google.protobuf.Timestamp
+	1, // 1: gofakeit.test.Invoice.items:type_name -> gofakeit.test.InvoiceItem
+	3, // 2: gofakeit.test.Invoice.start_time:type_name -> google.protobuf.Timestamp
+	3, // 3: gofakeit.test.Invoice.end_time:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_cel_proto_init() }
+func file_gofakeit_test_cel_proto_init() {
+	if File_gofakeit_test_cel_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_cel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_cel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_cel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Unsatisfiable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_cel_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_cel_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_cel_proto_depIdxs,
+		MessageInfos:      file_gofakeit_test_cel_proto_msgTypes,
+	}.Build()
+	File_gofakeit_test_cel_proto = out.File
+	file_gofakeit_test_cel_proto_rawDesc = nil
+	file_gofakeit_test_cel_proto_goTypes = nil
+	file_gofakeit_test_cel_proto_depIdxs = nil
+}
@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/embedded.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload       []byte   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	JsonPayload   string   `protobuf:"bytes,2,opt,name=json_payload,json=jsonPayload,proto3" json:"json_payload,omitempty"`
+	TextPayload   string   `protobuf:"bytes,3,opt,name=text_payload,json=textPayload,proto3" json:"text_payload,omitempty"`
+	Base64Payload string   `protobuf:"bytes,4,opt,name=base64_payload,json=base64Payload,proto3" json:"base64_payload,omitempty"`
+	JsonBytes     []byte   `protobuf:"bytes,5,opt,name=json_bytes,json=jsonBytes,proto3" json:"json_bytes,omitempty"`
+	Events        [][]byte `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	Next          []byte   `protobuf:"bytes,7,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_embedded_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_embedded_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_embedded_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Envelope) GetJsonPayload() string {
+	if x != nil {
+		return x.JsonPayload
+	}
+	return ""
+}
+
+func (x *Envelope) GetTextPayload() string {
+	if x != nil {
+		return x.TextPayload
+	}
+	return ""
+}
+
+func (x *Envelope) GetBase64Payload() string {
+	if x != nil {
+		return x.Base64Payload
+	}
+	return ""
+}
+
+func (x *Envelope) GetJsonBytes() []byte {
+	if x != nil {
+		return x.JsonBytes
+	}
+	return nil
+}
+
+func (x *Envelope) GetEvents() [][]byte {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Envelope) GetNext() []byte {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_embedded_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_embedded_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_embedded_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_gofakeit_test_embedded_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_embedded_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x03, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x1b, 0xca, 0xe6, 0x36, 0x17, 0x4a, 0x15, 0x0a, 0x13, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1b, 0xca, 0xe6, 0x36, 0x17, 0x4a, 0x15, 0x0a, 0x13, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
+	0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1d, 0xca, 0xe6, 0x36, 0x19, 0x4a, 0x17, 0x0a, 0x13, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
+	0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x52,
+	0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x0e,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xe6, 0x36, 0x19, 0x4a, 0x17, 0x0a, 0x13, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1f, 0xca, 0xe6, 0x36, 0x1b, 0x4a, 0x19, 0x0a, 0x13,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x10, 0x02, 0x18, 0x01, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0c, 0x42, 0x1f, 0xca, 0xe6, 0x36, 0x1b, 0x22, 0x19, 0x0a, 0x17, 0x4a, 0x15, 0x0a, 0x13,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1e, 0xca, 0xe6, 0x36, 0x1a, 0x4a,
+	0x18, 0x0a, 0x16, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22,
+	0x59, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xca, 0xe6, 0x36, 0x11, 0x3a, 0x0f, 0x65, 0x76, 0x74, 0x5f,
+	0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x38, 0x7d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x29, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13,
+	0xca, 0xe6, 0x36, 0x0f, 0x12, 0x0d, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x3a, 0x31, 0x2c,
+	0x31, 0x30, 0x7d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gofakeit_test_embedded_proto_rawDescOnce sync.Once
+	file_gofakeit_test_embedded_proto_rawDescData = file_gofakeit_test_embedded_proto_rawDesc
+)
+
+func file_gofakeit_test_embedded_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_embedded_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_embedded_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_embedded_proto_rawDescData)
+	})
+	return file_gofakeit_test_embedded_proto_rawDescData
+}
+
+var file_gofakeit_test_embedded_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_gofakeit_test_embedded_proto_goTypes = []interface{}{
+	(*Envelope)(nil), // 0: gofakeit.test.Envelope
+	(*Event)(nil),    // 1: gofakeit.test.Event
+}
+var file_gofakeit_test_embedded_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_embedded_proto_init() }
+func file_gofakeit_test_embedded_proto_init() {
+	if File_gofakeit_test_embedded_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_embedded_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_embedded_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_embedded_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_embedded_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_embedded_proto_depIdxs,
+		MessageInfos:      file_gofakeit_test_embedded_proto_msgTypes,
+	}.Build()
+	File_gofakeit_test_embedded_proto = out.File
+	file_gofakeit_test_embedded_proto_rawDesc = nil
+	file_gofakeit_test_embedded_proto_goTypes = nil
+	file_gofakeit_test_embedded_proto_depIdxs = nil
+}
@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/invalid.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Invalid contains misconfigured generators, reported by Validate.
+type Invalid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListRange        []string               `protobuf:"bytes,1,rep,name=list_range,json=listRange,proto3" json:"list_range,omitempty"`
+	MapRange         map[string]string      `protobuf:"bytes,2,rep,name=map_range,json=mapRange,proto3" json:"map_range,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MessageTag       *Custom                `protobuf:"bytes,3,opt,name=message_tag,json=messageTag,proto3" json:"message_tag,omitempty"`
+	RepeatedSingular string                 `protobuf:"bytes,4,opt,name=repeated_singular,json=repeatedSingular,proto3" json:"repeated_singular,omitempty"`
+	MapList          []string               `protobuf:"bytes,5,rep,name=map_list,json=mapList,proto3" json:"map_list,omitempty"`
+	Template         string                 `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	UnknownFunc      string                 `protobuf:"bytes,7,opt,name=unknown_func,json=unknownFunc,proto3" json:"unknown_func,omitempty"`
+	Constant         int32                  `protobuf:"varint,8,opt,name=constant,proto3" json:"constant,omitempty"`
+	MapValueTag      map[string]*Custom     `protobuf:"bytes,9,rep,name=map_value_tag,json=mapValueTag,proto3" json:"map_value_tag,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	StringsInt       int32                  `protobuf:"varint,11,opt,name=strings_int,json=stringsInt,proto3" json:"strings_int,omitempty"`
+	UnknownScript    string                 `protobuf:"bytes,12,opt,name=unknown_script,json=unknownScript,proto3" json:"unknown_script,omitempty"`
+	WordBoundary     string                 `protobuf:"bytes,13,opt,name=word_boundary,json=wordBoundary,proto3" json:"word_boundary,omitempty"`
+	IntPattern       int32                  `protobuf:"varint,14,opt,name=int_pattern,json=intPattern,proto3" json:"int_pattern,omitempty"`
+	BytesString      string                 `protobuf:"bytes,15,opt,name=bytes_string,json=bytesString,proto3" json:"bytes_string,omitempty"`
+	BadHex           []byte                 `protobuf:"bytes,16,opt,name=bad_hex,json=badHex,proto3" json:"bad_hex,omitempty"`
+	EmbeddedInt      int32                  `protobuf:"varint,17,opt,name=embedded_int,json=embeddedInt,proto3" json:"embedded_int,omitempty"`
+	EmbeddedUnknown  string                 `protobuf:"bytes,18,opt,name=embedded_unknown,json=embeddedUnknown,proto3" json:"embedded_unknown,omitempty"`
+	EmbeddedBinary   string                 `protobuf:"bytes,19,opt,name=embedded_binary,json=embeddedBinary,proto3" json:"embedded_binary,omitempty"`
+	CycleA           string                 `protobuf:"bytes,20,opt,name=cycle_a,json=cycleA,proto3" json:"cycle_a,omitempty"`
+	CycleB           string                 `protobuf:"bytes,21,opt,name=cycle_b,json=cycleB,proto3" json:"cycle_b,omitempty"`
+	UnknownReference string                 `protobuf:"bytes,22,opt,name=unknown_reference,json=unknownReference,proto3" json:"unknown_reference,omitempty"`
+	CopyMismatch     int32                  `protobuf:"varint,23,opt,name=copy_mismatch,json=copyMismatch,proto3" json:"copy_mismatch,omitempty"`
+	CopyElement      []string               `protobuf:"bytes,24,rep,name=copy_element,json=copyElement,proto3" json:"copy_element,omitempty"`
+	BadCel           string                 `protobuf:"bytes,25,opt,name=bad_cel,json=badCel,proto3" json:"bad_cel,omitempty"`
+	CelElement       []string               `protobuf:"bytes,26,rep,name=cel_element,json=celElement,proto3" json:"cel_element,omitempty"`
+}
+
+func (x *Invalid) Reset() {
+	*x = Invalid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_invalid_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invalid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invalid) ProtoMessage() {}
+
+func (x *Invalid) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_invalid_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invalid.ProtoReflect.Descriptor instead.
+func (*Invalid) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_invalid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invalid) GetListRange() []string {
+	if x != nil {
+		return x.ListRange
+	}
+	return nil
+}
+
+func (x *Invalid) GetMapRange() map[string]string {
+	if x != nil {
+		return x.MapRange
+	}
+	return nil
+}
+
+func (x *Invalid) GetMessageTag() *Custom {
+	if x != nil {
+		return x.MessageTag
+	}
+	return nil
+}
+
+func (x *Invalid) GetRepeatedSingular() string {
+	if x != nil {
+		return x.RepeatedSingular
+	}
+	return ""
+}
+
+func (x *Invalid) GetMapList() []string {
+	if x != nil {
+		return x.MapList
+	}
+	return nil
+}
+
+func (x *Invalid) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Invalid) GetUnknownFunc() string {
+	if x != nil {
+		return x.UnknownFunc
+	}
+	return ""
+}
+
+func (x *Invalid) GetConstant() int32 {
+	if x != nil {
+		return x.Constant
+	}
+	return 0
+}
+
+func (x *Invalid) GetMapValueTag() map[string]*Custom {
+	if x != nil {
+		return x.MapValueTag
+	}
+	return nil
+}
+
+func (x *Invalid) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Invalid) GetStringsInt() int32 {
+	if x != nil {
+		return x.StringsInt
+	}
+	return 0
+}
+
+func (x *Invalid) GetUnknownScript() string {
+	if x != nil {
+		return x.UnknownScript
+	}
+	return ""
+}
+
+func (x *Invalid) GetWordBoundary() string {
+	if x != nil {
+		return x.WordBoundary
+	}
+	return ""
+}
+
+func (x *Invalid) GetIntPattern() int32 {
+	if x != nil {
+		return x.IntPattern
+	}
+	return 0
+}
+
+func (x *Invalid) GetBytesString() string {
+	if x != nil {
+		return x.BytesString
+	}
+	return ""
+}
+
+func (x *Invalid) GetBadHex() []byte {
+	if x != nil {
+		return x.BadHex
+	}
+	return nil
+}
+
+func (x *Invalid) GetEmbeddedInt() int32 {
+	if x != nil {
+		return x.EmbeddedInt
+	}
+	return 0
+}
+
+func (x *Invalid) GetEmbeddedUnknown() string {
+	if x != nil {
+		return x.EmbeddedUnknown
+	}
+	return ""
+}
+
+func (x *Invalid) GetEmbeddedBinary() string {
+	if x != nil {
+		return x.EmbeddedBinary
+	}
+	return ""
+}
+
+func (x *Invalid) GetCycleA() string {
+	if x != nil {
+		return x.CycleA
+	}
+	return ""
+}
+
+func (x *Invalid) GetCycleB() string {
+	if x != nil {
+		return x.CycleB
+	}
+	return ""
+}
+
+func (x *Invalid) GetUnknownReference() string {
+	if x != nil {
+		return x.UnknownReference
+	}
+	return ""
+}
+
+func (x *Invalid) GetCopyMismatch() int32 {
+	if x != nil {
+		return x.CopyMismatch
+	}
+	return 0
+}
+
+func (x *Invalid) GetCopyElement() []string {
+	if x != nil {
+		return x.CopyElement
+	}
+	return nil
+}
+
+func (x *Invalid) GetBadCel() string {
+	if x != nil {
+		return x.BadCel
+	}
+	return ""
+}
+
+func (x *Invalid) GetCelElement() []string {
+	if x != nil {
+		return x.CelElement
+	}
+	return nil
+}
+
+// InvalidUser fails to generate a value deep within the message.
+type InvalidUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pets map[string]*InvalidPet `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InvalidUser) Reset() {
+	*x = InvalidUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_invalid_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidUser) ProtoMessage() {}
+
+func (x *InvalidUser) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_invalid_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidUser.ProtoReflect.Descriptor instead.
+func (*InvalidUser) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_invalid_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InvalidUser) GetPets() map[string]*InvalidPet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+type InvalidPet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner *InvalidOwner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *InvalidPet) Reset() {
+	*x = InvalidPet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_invalid_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidPet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidPet) ProtoMessage() {}
+
+func (x *InvalidPet) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_invalid_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidPet.ProtoReflect.Descriptor instead.
+func (*InvalidPet) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_invalid_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InvalidPet) GetOwner() *InvalidOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type InvalidOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ages []int32 `protobuf:"varint,1,rep,packed,name=ages,proto3" json:"ages,omitempty"`
+}
+
+func (x *InvalidOwner) Reset() {
+	*x = InvalidOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_invalid_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidOwner) ProtoMessage() {}
+
+func (x *InvalidOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_invalid_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidOwner.ProtoReflect.Descriptor instead.
+func (*InvalidOwner) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_invalid_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvalidOwner) GetAges() []int32 {
+	if x != nil {
+		return x.Ages
+	}
+	return nil
+}
+
+type Invalid_Nested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Element []int64 `protobuf:"varint,1,rep,packed,name=element,proto3" json:"element,omitempty"`
+}
+
+func (x *Invalid_Nested) Reset() {
+	*x = Invalid_Nested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_invalid_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invalid_Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invalid_Nested) ProtoMessage() {}
+
+func (x *Invalid_Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_invalid_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invalid_Nested.ProtoReflect.Descriptor instead.
+func (*Invalid_Nested) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_invalid_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Invalid_Nested) GetElement() []int64 {
+	if x != nil {
+		return x.Element
+	}
+	return nil
+}
+
+var File_gofakeit_test_invalid_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_invalid_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x82, 0x0d, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x22, 0x06, 0x32, 0x04, 0x08, 0x05, 0x10,
+	0x01, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x09,
+	0x6d, 0x61, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x2a, 0x06, 0x12, 0x04, 0x08,
+	0x03, 0x10, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x49, 0x0a,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x11, 0xca, 0xe6, 0x36, 0x0d, 0x12,
+	0x0b, 0x7b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x52, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xe6, 0x36, 0x04, 0x22, 0x02, 0x28, 0x02, 0x52, 0x10, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12,
+	0x23, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x08, 0xca, 0xe6, 0x36, 0x04, 0x2a, 0x02, 0x08, 0x02, 0x52, 0x07, 0x6d, 0x61, 0x70,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xe6, 0x36, 0x04, 0x1a, 0x02, 0x7b, 0x7b,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xca, 0xe6, 0x36, 0x10, 0x12, 0x0e, 0x7b, 0x6e, 0x6f, 0x74, 0x61, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x52, 0x0b, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46,
+	0x75, 0x6e, 0x63, 0x12, 0x25, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xca, 0xe6, 0x36, 0x05, 0x12, 0x03, 0x61, 0x62, 0x63,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0d, 0x6d, 0x61,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x61, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0d, 0xca, 0xe6, 0x36, 0x09,
+	0x2a, 0x07, 0x22, 0x05, 0x12, 0x03, 0x66, 0x6f, 0x6f, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x61, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0f, 0xca, 0xe6, 0x36, 0x0b, 0x12, 0x09, 0x79, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x29, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xca, 0xe6, 0x36, 0x04, 0x32, 0x02, 0x08, 0x04,
+	0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0e,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xe6, 0x36, 0x0d, 0x32, 0x0b, 0x08, 0x08, 0x12, 0x07,
+	0x4b, 0x6c, 0x69, 0x6e, 0x67, 0x6f, 0x6e, 0x52, 0x0d, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x30, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xca,
+	0xe6, 0x36, 0x07, 0x3a, 0x05, 0x5c, 0x62, 0x66, 0x6f, 0x6f, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x64,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xca,
+	0xe6, 0x36, 0x07, 0x3a, 0x05, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2b, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xe6,
+	0x36, 0x04, 0x42, 0x02, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x64, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x42, 0x06, 0x08, 0x02, 0x12, 0x02,
+	0x7a, 0x7a, 0x52, 0x06, 0x62, 0x61, 0x64, 0x48, 0x65, 0x78, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x1d, 0xca, 0xe6, 0x36, 0x19, 0x4a, 0x17, 0x0a, 0x15, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
+	0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52,
+	0x0b, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x10,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xe6, 0x36, 0x19, 0x4a, 0x17, 0x0a, 0x15,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x48, 0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1f, 0xca, 0xe6, 0x36, 0x1b, 0x4a, 0x19, 0x0a, 0x15, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x01,
+	0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x12, 0x26, 0x0a, 0x07, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x61, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xca, 0xe6, 0x36, 0x09, 0x52, 0x07, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x62,
+	0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x41, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x5f, 0x62, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xe6, 0x36, 0x18, 0x1a,
+	0x16, 0x7b, 0x7b, 0x20, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x22, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x5f, 0x61, 0x22, 0x20, 0x7d, 0x7d, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x42, 0x12,
+	0x49, 0x0a, 0x11, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xe6, 0x36, 0x18,
+	0x1a, 0x16, 0x7b, 0x7b, 0x20, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x22, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x7d, 0x7d, 0x52, 0x10, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x63, 0x6f,
+	0x70, 0x79, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0d, 0xca, 0xe6, 0x36, 0x09, 0x52, 0x07, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x61,
+	0x52, 0x0c, 0x63, 0x6f, 0x70, 0x79, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x34,
+	0x0a, 0x0c, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x18,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0xca, 0xe6, 0x36, 0x0d, 0x22, 0x0b, 0x0a, 0x09, 0x52, 0x07,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x61, 0x52, 0x0b, 0x63, 0x6f, 0x70, 0x79, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x62, 0x61, 0x64, 0x5f, 0x63, 0x65, 0x6c, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xe6, 0x36, 0x0e, 0x5a, 0x0c, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x61, 0x64, 0x43, 0x65,
+	0x6c, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xe6, 0x36, 0x09, 0x22, 0x07, 0x0a, 0x05,
+	0x5a, 0x03, 0x27, 0x61, 0x27, 0x52, 0x0a, 0x63, 0x65, 0x6c, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55,
+	0x0a, 0x10, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x61, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x31, 0x0a, 0x06, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x27, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x42, 0x0d, 0xca, 0xe6, 0x36, 0x09, 0x22, 0x07, 0x0a, 0x05, 0x12, 0x03, 0x31, 0x2e, 0x35, 0x52,
+	0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x04, 0x70, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0xca, 0xe6,
+	0x36, 0x0e, 0x2a, 0x0c, 0x1a, 0x08, 0x12, 0x06, 0x41, 0x72, 0x63, 0x68, 0x69, 0x65, 0x08, 0x01,
+	0x52, 0x04, 0x70, 0x65, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x09, 0x50, 0x65, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3f, 0x0a, 0x0a, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
+	0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x0c, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0f, 0xca, 0xe6, 0x36, 0x0b, 0x22,
+	0x09, 0x0a, 0x05, 0x12, 0x03, 0x61, 0x62, 0x63, 0x28, 0x01, 0x52, 0x04, 0x61, 0x67, 0x65, 0x73,
+	0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+	0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gofakeit_test_invalid_proto_rawDescOnce sync.Once
+	file_gofakeit_test_invalid_proto_rawDescData = file_gofakeit_test_invalid_proto_rawDesc
+)
+
+func file_gofakeit_test_invalid_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_invalid_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_invalid_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_invalid_proto_rawDescData)
+	})
+	return file_gofakeit_test_invalid_proto_rawDescData
+}
+
+var file_gofakeit_test_invalid_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_gofakeit_test_invalid_proto_goTypes = []interface{}{
+	(*Invalid)(nil),               // 0: gofakeit.test.Invalid
+	(*InvalidUser)(nil),           // 1: gofakeit.test.InvalidUser
+	(*InvalidPet)(nil),            // 2: gofakeit.test.InvalidPet
+	(*InvalidOwner)(nil),          // 3: gofakeit.test.InvalidOwner
+	nil,                           // 4: gofakeit.test.Invalid.MapRangeEntry
+	nil,                           // 5: gofakeit.test.Invalid.MapValueTagEntry
+	(*Invalid_Nested)(nil),        // 6: gofakeit.test.Invalid.Nested
+	nil,                           // 7: gofakeit.test.InvalidUser.PetsEntry
+	(*Custom)(nil),                // 8: gofakeit.test.Custom
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_gofakeit_test_invalid_proto_depIdxs = []int32{
+	4, // 0: gofakeit.test.Invalid.map_range:type_name -> gofakeit.test.Invalid.MapRangeEntry
+	8, // 1: gofakeit.test.Invalid.message_tag:type_name -> gofakeit.test.Custom
+	5, // 2: gofakeit.test.Invalid.map_value_tag:type_name -> gofakeit.test.Invalid.MapValueTagEntry
+	9, // 3: gofakeit.test.Invalid.timestamp:type_name -> google.protobuf.Timestamp
+	7, // 4: gofakeit.test.InvalidUser.pets:type_name -> gofakeit.test.InvalidUser.PetsEntry
+	3, // 5: gofakeit.test.InvalidPet.owner:type_name -> gofakeit.test.InvalidOwner
+	8, // 6: gofakeit.test.Invalid.MapValueTagEntry.value:type_name -> gofakeit.test.Custom
+	2, // 7: gofakeit.test.InvalidUser.PetsEntry.value:type_name -> gofakeit.test.InvalidPet
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_invalid_proto_init() }
+func file_gofakeit_test_invalid_proto_init() {
+	if File_gofakeit_test_invalid_proto != nil {
+		return
+	}
+	file_gofakeit_test_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_invalid_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invalid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_invalid_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_invalid_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidPet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_invalid_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_invalid_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invalid_Nested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_invalid_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_invalid_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_invalid_proto_depIdxs,
+		MessageInfos:      file_gofakeit_test_invalid_proto_msgTypes,
+	}.Build()
+	File_gofakeit_test_invalid_proto = out.File
+	file_gofakeit_test_invalid_proto_rawDesc = nil
+	file_gofakeit_test_invalid_proto_goTypes = nil
+	file_gofakeit_test_invalid_proto_depIdxs = nil
+}
@@ -161,6 +161,152 @@ func (x *MessageSkipped) GetSkipped() *MessageSkipped {
 	return nil
 }
 
+type Custom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Other int32  `protobuf:"varint,2,opt,name=other,proto3" json:"other,omitempty"`
+}
+
+func (x *Custom) Reset() {
+	*x = Custom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_message_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Custom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Custom) ProtoMessage() {}
+
+func (x *Custom) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_message_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Custom.ProtoReflect.Descriptor instead.
+func (*Custom) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Custom) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Custom) GetOther() int32 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
+}
+
+type CustomContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Singular *Custom            `protobuf:"bytes,1,opt,name=singular,proto3" json:"singular,omitempty"`
+	List     []*Custom          `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	Map      map[string]*Custom `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//
+	//	*CustomContainer_Option
+	Choice isCustomContainer_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *CustomContainer) Reset() {
+	*x = CustomContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_message_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomContainer) ProtoMessage() {}
+
+func (x *CustomContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_message_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomContainer.ProtoReflect.Descriptor instead.
+func (*CustomContainer) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CustomContainer) GetSingular() *Custom {
+	if x != nil {
+		return x.Singular
+	}
+	return nil
+}
+
+func (x *CustomContainer) GetList() []*Custom {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *CustomContainer) GetMap() map[string]*Custom {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (m *CustomContainer) GetChoice() isCustomContainer_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *CustomContainer) GetOption() *Custom {
+	if x, ok := x.GetChoice().(*CustomContainer_Option); ok {
+		return x.Option
+	}
+	return nil
+}
+
+type isCustomContainer_Choice interface {
+	isCustomContainer_Choice()
+}
+
+type CustomContainer_Option struct {
+	Option *Custom `protobuf:"bytes,4,opt,name=option,proto3,oneof"`
+}
+
+func (*CustomContainer_Option) isCustomContainer_Choice() {}
+
 type PairRecursive_A struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -172,7 +318,7 @@ type PairRecursive_A struct {
 func (x *PairRecursive_A) Reset() {
 	*x = PairRecursive_A{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gofakeit_test_message_proto_msgTypes[3]
+		mi := &file_gofakeit_test_message_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -185,7 +331,7 @@ func (x *PairRecursive_A) String() string {
 func (*PairRecursive_A) ProtoMessage() {}
 
 func (x *PairRecursive_A) ProtoReflect() protoreflect.Message {
-	mi := &file_gofakeit_test_message_proto_msgTypes[3]
+	mi := &file_gofakeit_test_message_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +365,7 @@ type PairRecursive_B struct {
 func (x *PairRecursive_B) Reset() {
 	*x = PairRecursive_B{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gofakeit_test_message_proto_msgTypes[4]
+		mi := &file_gofakeit_test_message_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -232,7 +378,7 @@ func (x *PairRecursive_B) String() string {
 func (*PairRecursive_B) ProtoMessage() {}
 
 func (x *PairRecursive_B) ProtoReflect() protoreflect.Message {
-	mi := &file_gofakeit_test_message_proto_msgTypes[4]
+	mi := &file_gofakeit_test_message_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,11 +427,34 @@ var file_gofakeit_test_message_proto_rawDesc = []byte{
 	0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x66, 0x61,
 	0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x42, 0x06, 0xca, 0xe6, 0x36, 0x02, 0x08, 0x01,
-	0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x06, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x22,
+	0xb4, 0x02, 0x0a, 0x0f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x08, 0x73, 0x69,
+	0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x2f, 0x0a, 0x06,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x4d, 0x0a,
+	0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x66,
+	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -300,24 +469,32 @@ func file_gofakeit_test_message_proto_rawDescGZIP() []byte {
 	return file_gofakeit_test_message_proto_rawDescData
 }
 
-var file_gofakeit_test_message_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_gofakeit_test_message_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_gofakeit_test_message_proto_goTypes = []interface{}{
 	(*SelfRecursive)(nil),   // 0: gofakeit.test.SelfRecursive
 	(*PairRecursive)(nil),   // 1: gofakeit.test.PairRecursive
 	(*MessageSkipped)(nil),  // 2: gofakeit.test.MessageSkipped
-	(*PairRecursive_A)(nil), // 3: gofakeit.test.PairRecursive.A
-	(*PairRecursive_B)(nil), // 4: gofakeit.test.PairRecursive.B
+	(*Custom)(nil),          // 3: gofakeit.test.Custom
+	(*CustomContainer)(nil), // 4: gofakeit.test.CustomContainer
+	(*PairRecursive_A)(nil), // 5: gofakeit.test.PairRecursive.A
+	(*PairRecursive_B)(nil), // 6: gofakeit.test.PairRecursive.B
+	nil,                     // 7: gofakeit.test.CustomContainer.MapEntry
 }
 var file_gofakeit_test_message_proto_depIdxs = []int32{
 	0, // 0: gofakeit.test.SelfRecursive.recurse:type_name -> gofakeit.test.SelfRecursive
 	2, // 1: gofakeit.test.MessageSkipped.skipped:type_name -> gofakeit.test.MessageSkipped
-	4, // 2: gofakeit.test.PairRecursive.A.b:type_name -> gofakeit.test.PairRecursive.B
-	3, // 3: gofakeit.test.PairRecursive.B.a:type_name -> gofakeit.test.PairRecursive.A
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 2: gofakeit.test.CustomContainer.singular:type_name -> gofakeit.test.Custom
+	3, // 3: gofakeit.test.CustomContainer.list:type_name -> gofakeit.test.Custom
+	7, // 4: gofakeit.test.CustomContainer.map:type_name -> gofakeit.test.CustomContainer.MapEntry
+	3, // 5: gofakeit.test.CustomContainer.option:type_name -> gofakeit.test.Custom
+	6, // 6: gofakeit.test.PairRecursive.A.b:type_name -> gofakeit.test.PairRecursive.B
+	5, // 7: gofakeit.test.PairRecursive.B.a:type_name -> gofakeit.test.PairRecursive.A
+	3, // 8: gofakeit.test.CustomContainer.MapEntry.value:type_name -> gofakeit.test.Custom
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_gofakeit_test_message_proto_init() }
@@ -363,7 +540,7 @@ func file_gofakeit_test_message_proto_init() {
 			}
 		}
 		file_gofakeit_test_message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PairRecursive_A); i {
+			switch v := v.(*Custom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -375,6 +552,30 @@ func file_gofakeit_test_message_proto_init() {
 			}
 		}
 		file_gofakeit_test_message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairRecursive_A); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PairRecursive_B); i {
 			case 0:
 				return &v.state
@@ -387,13 +588,16 @@ func file_gofakeit_test_message_proto_init() {
 			}
 		}
 	}
+	file_gofakeit_test_message_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*CustomContainer_Option)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gofakeit_test_message_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
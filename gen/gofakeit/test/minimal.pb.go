@@ -0,0 +1,842 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/minimal.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FieldBehavior int32
+
+const (
+	FieldBehavior_FIELD_BEHAVIOR_UNSPECIFIED FieldBehavior = 0
+	FieldBehavior_FIELD_BEHAVIOR_OPTIONAL    FieldBehavior = 1
+	FieldBehavior_FIELD_BEHAVIOR_REQUIRED    FieldBehavior = 2
+)
+
+// Enum value maps for FieldBehavior.
+var (
+	FieldBehavior_name = map[int32]string{
+		0: "FIELD_BEHAVIOR_UNSPECIFIED",
+		1: "FIELD_BEHAVIOR_OPTIONAL",
+		2: "FIELD_BEHAVIOR_REQUIRED",
+	}
+	FieldBehavior_value = map[string]int32{
+		"FIELD_BEHAVIOR_UNSPECIFIED": 0,
+		"FIELD_BEHAVIOR_OPTIONAL":    1,
+		"FIELD_BEHAVIOR_REQUIRED":    2,
+	}
+)
+
+func (x FieldBehavior) Enum() *FieldBehavior {
+	p := new(FieldBehavior)
+	*p = x
+	return p
+}
+
+func (x FieldBehavior) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldBehavior) Descriptor() protoreflect.EnumDescriptor {
+	return file_gofakeit_test_minimal_proto_enumTypes[0].Descriptor()
+}
+
+func (FieldBehavior) Type() protoreflect.EnumType {
+	return &file_gofakeit_test_minimal_proto_enumTypes[0]
+}
+
+func (x FieldBehavior) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *FieldBehavior) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = FieldBehavior(num)
+	return nil
+}
+
+// Deprecated: Use FieldBehavior.Descriptor instead.
+func (FieldBehavior) EnumDescriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{0}
+}
+
+type FieldRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required *bool          `protobuf:"varint,25,opt,name=required" json:"required,omitempty"`
+	String_  *StringRules   `protobuf:"bytes,14,opt,name=string" json:"string,omitempty"`
+	Repeated *RepeatedRules `protobuf:"bytes,18,opt,name=repeated" json:"repeated,omitempty"`
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_minimal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_minimal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetString_() *StringRules {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *FieldRules) GetRepeated() *RepeatedRules {
+	if x != nil {
+		return x.Repeated
+	}
+	return nil
+}
+
+type StringRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern *string `protobuf:"bytes,6,opt,name=pattern" json:"pattern,omitempty"`
+}
+
+func (x *StringRules) Reset() {
+	*x = StringRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_minimal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringRules) ProtoMessage() {}
+
+func (x *StringRules) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_minimal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringRules.ProtoReflect.Descriptor instead.
+func (*StringRules) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StringRules) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+type RepeatedRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items *FieldRules `protobuf:"bytes,4,opt,name=items" json:"items,omitempty"`
+}
+
+func (x *RepeatedRules) Reset() {
+	*x = RepeatedRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_minimal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedRules) ProtoMessage() {}
+
+func (x *RepeatedRules) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_minimal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedRules.ProtoReflect.Descriptor instead.
+func (*RepeatedRules) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepeatedRules) GetItems() *FieldRules {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type OneofRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required *bool `protobuf:"varint,1,opt,name=required" json:"required,omitempty"`
+}
+
+func (x *OneofRules) Reset() {
+	*x = OneofRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_minimal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneofRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofRules) ProtoMessage() {}
+
+func (x *OneofRules) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_minimal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofRules.ProtoReflect.Descriptor instead.
+func (*OneofRules) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OneofRules) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+type Minimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       *string           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Nickname   *string           `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	Id         *int32            `protobuf:"varint,3,opt,name=id" json:"id,omitempty"`
+	Email      *string           `protobuf:"bytes,4,opt,name=email" json:"email,omitempty"`
+	Tags       []string          `protobuf:"bytes,5,rep,name=tags" json:"tags,omitempty"`
+	Aliases    []string          `protobuf:"bytes,6,rep,name=aliases" json:"aliases,omitempty"`
+	Labels     map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Attributes map[string]string `protobuf:"bytes,8,rep,name=attributes" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Child      *MinimalChild     `protobuf:"bytes,9,opt,name=child" json:"child,omitempty"`
+	OtherChild *MinimalChild     `protobuf:"bytes,10,opt,name=other_child,json=otherChild" json:"other_child,omitempty"`
+	// Types that are assignable to Kind:
+	//
+	//	*Minimal_A
+	//	*Minimal_B
+	Kind isMinimal_Kind `protobuf_oneof:"kind"`
+	// Types that are assignable to OptionalKind:
+	//
+	//	*Minimal_C
+	//	*Minimal_D
+	OptionalKind isMinimal_OptionalKind `protobuf_oneof:"optional_kind"`
+}
+
+func (x *Minimal) Reset() {
+	*x = Minimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_minimal_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Minimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Minimal) ProtoMessage() {}
+
+func (x *Minimal) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_minimal_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Minimal.ProtoReflect.Descriptor instead.
+func (*Minimal) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Minimal) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Minimal) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Minimal) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Minimal) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *Minimal) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Minimal) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Minimal) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Minimal) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Minimal) GetChild() *MinimalChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Minimal) GetOtherChild() *MinimalChild {
+	if x != nil {
+		return x.OtherChild
+	}
+	return nil
+}
+
+func (m *Minimal) GetKind() isMinimal_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Minimal) GetA() string {
+	if x, ok := x.GetKind().(*Minimal_A); ok {
+		return x.A
+	}
+	return ""
+}
+
+func (x *Minimal) GetB() int32 {
+	if x, ok := x.GetKind().(*Minimal_B); ok {
+		return x.B
+	}
+	return 0
+}
+
+func (m *Minimal) GetOptionalKind() isMinimal_OptionalKind {
+	if m != nil {
+		return m.OptionalKind
+	}
+	return nil
+}
+
+func (x *Minimal) GetC() string {
+	if x, ok := x.GetOptionalKind().(*Minimal_C); ok {
+		return x.C
+	}
+	return ""
+}
+
+func (x *Minimal) GetD() string {
+	if x, ok := x.GetOptionalKind().(*Minimal_D); ok {
+		return x.D
+	}
+	return ""
+}
+
+type isMinimal_Kind interface {
+	isMinimal_Kind()
+}
+
+type Minimal_A struct {
+	A string `protobuf:"bytes,11,opt,name=a,oneof"`
+}
+
+type Minimal_B struct {
+	B int32 `protobuf:"varint,12,opt,name=b,oneof"`
+}
+
+func (*Minimal_A) isMinimal_Kind() {}
+
+func (*Minimal_B) isMinimal_Kind() {}
+
+type isMinimal_OptionalKind interface {
+	isMinimal_OptionalKind()
+}
+
+type Minimal_C struct {
+	C string `protobuf:"bytes,13,opt,name=c,oneof"`
+}
+
+type Minimal_D struct {
+	D string `protobuf:"bytes,14,opt,name=d,oneof"`
+}
+
+func (*Minimal_C) isMinimal_OptionalKind() {}
+
+func (*Minimal_D) isMinimal_OptionalKind() {}
+
+type MinimalChild struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *string `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	Note  *string `protobuf:"bytes,2,opt,name=note" json:"note,omitempty"`
+}
+
+func (x *MinimalChild) Reset() {
+	*x = MinimalChild{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_minimal_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinimalChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinimalChild) ProtoMessage() {}
+
+func (x *MinimalChild) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_minimal_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinimalChild.ProtoReflect.Descriptor instead.
+func (*MinimalChild) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_minimal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MinimalChild) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *MinimalChild) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+var file_gofakeit_test_minimal_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: ([]FieldBehavior)(nil),
+		Field:         1052,
+		Name:          "gofakeit.test.field_behavior",
+		Tag:           "varint,1052,rep,name=field_behavior,enum=gofakeit.test.FieldBehavior",
+		Filename:      "gofakeit/test/minimal.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         1159,
+		Name:          "gofakeit.test.rules",
+		Tag:           "bytes,1159,opt,name=rules",
+		Filename:      "gofakeit/test/minimal.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
+		ExtensionType: (*OneofRules)(nil),
+		Field:         1159,
+		Name:          "gofakeit.test.oneof_rules",
+		Tag:           "bytes,1159,opt,name=oneof_rules",
+		Filename:      "gofakeit/test/minimal.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// repeated gofakeit.test.FieldBehavior field_behavior = 1052;
+	E_FieldBehavior = &file_gofakeit_test_minimal_proto_extTypes[0]
+	// optional gofakeit.test.FieldRules rules = 1159;
+	E_Rules = &file_gofakeit_test_minimal_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.OneofOptions.
+var (
+	// optional gofakeit.test.OneofRules oneof_rules = 1159;
+	E_OneofRules = &file_gofakeit_test_minimal_proto_extTypes[2]
+)
+
+var File_gofakeit_test_minimal_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_minimal_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b,
+	0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x27, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x28, 0x0a, 0x0a, 0x4f,
+	0x6e, 0x65, 0x6f, 0x66, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x84, 0x05, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x22, 0x06, 0x32, 0x04, 0x08, 0x02, 0x10, 0x05,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x12, 0x3f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b,
+	0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x01, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x01, 0x61, 0x12, 0x0e, 0x0a, 0x01, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x01, 0x62, 0x12, 0x0e, 0x0a, 0x01, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x01, 0x63, 0x12, 0x0e, 0x0a, 0x01, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x01, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x38, 0x0a, 0x0c,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x2a, 0x69, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x45,
+	0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x02, 0x3a, 0x63, 0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x9c, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x3a, 0x4f, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x87,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x5a, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x87, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74,
+}
+
+var (
+	file_gofakeit_test_minimal_proto_rawDescOnce sync.Once
+	file_gofakeit_test_minimal_proto_rawDescData = file_gofakeit_test_minimal_proto_rawDesc
+)
+
+func file_gofakeit_test_minimal_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_minimal_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_minimal_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_minimal_proto_rawDescData)
+	})
+	return file_gofakeit_test_minimal_proto_rawDescData
+}
+
+var file_gofakeit_test_minimal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gofakeit_test_minimal_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_gofakeit_test_minimal_proto_goTypes = []interface{}{
+	(FieldBehavior)(0),                // 0: gofakeit.test.FieldBehavior
+	(*FieldRules)(nil),                // 1: gofakeit.test.FieldRules
+	(*StringRules)(nil),               // 2: gofakeit.test.StringRules
+	(*RepeatedRules)(nil),             // 3: gofakeit.test.RepeatedRules
+	(*OneofRules)(nil),                // 4: gofakeit.test.OneofRules
+	(*Minimal)(nil),                   // 5: gofakeit.test.Minimal
+	(*MinimalChild)(nil),              // 6: gofakeit.test.MinimalChild
+	nil,                               // 7: gofakeit.test.Minimal.LabelsEntry
+	nil,                               // 8: gofakeit.test.Minimal.AttributesEntry
+	(*descriptorpb.FieldOptions)(nil), // 9: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil), // 10: google.protobuf.OneofOptions
+}
+var file_gofakeit_test_minimal_proto_depIdxs = []int32{
+	2,  // 0: gofakeit.test.FieldRules.string:type_name -> gofakeit.test.StringRules
+	3,  // 1: gofakeit.test.FieldRules.repeated:type_name -> gofakeit.test.RepeatedRules
+	1,  // 2: gofakeit.test.RepeatedRules.items:type_name -> gofakeit.test.FieldRules
+	7,  // 3: gofakeit.test.Minimal.labels:type_name -> gofakeit.test.Minimal.LabelsEntry
+	8,  // 4: gofakeit.test.Minimal.attributes:type_name -> gofakeit.test.Minimal.AttributesEntry
+	6,  // 5: gofakeit.test.Minimal.child:type_name -> gofakeit.test.MinimalChild
+	6,  // 6: gofakeit.test.Minimal.other_child:type_name -> gofakeit.test.MinimalChild
+	9,  // 7: gofakeit.test.field_behavior:extendee -> google.protobuf.FieldOptions
+	9,  // 8: gofakeit.test.rules:extendee -> google.protobuf.FieldOptions
+	10, // 9: gofakeit.test.oneof_rules:extendee -> google.protobuf.OneofOptions
+	0,  // 10: gofakeit.test.field_behavior:type_name -> gofakeit.test.FieldBehavior
+	1,  // 11: gofakeit.test.rules:type_name -> gofakeit.test.FieldRules
+	4,  // 12: gofakeit.test.oneof_rules:type_name -> gofakeit.test.OneofRules
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	10, // [10:13] is the sub-list for extension type_name
+	7,  // [7:10] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_minimal_proto_init() }
+func file_gofakeit_test_minimal_proto_init() {
+	if File_gofakeit_test_minimal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_minimal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_minimal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_minimal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_minimal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneofRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_minimal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Minimal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_minimal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinimalChild); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_gofakeit_test_minimal_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*Minimal_A)(nil),
+		(*Minimal_B)(nil),
+		(*Minimal_C)(nil),
+		(*Minimal_D)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_minimal_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 3,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_minimal_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_minimal_proto_depIdxs,
+		EnumInfos:         file_gofakeit_test_minimal_proto_enumTypes,
+		MessageInfos:      file_gofakeit_test_minimal_proto_msgTypes,
+		ExtensionInfos:    file_gofakeit_test_minimal_proto_extTypes,
+	}.Build()
+	File_gofakeit_test_minimal_proto = out.File
+	file_gofakeit_test_minimal_proto_rawDesc = nil
+	file_gofakeit_test_minimal_proto_goTypes = nil
+	file_gofakeit_test_minimal_proto_depIdxs = nil
+}
@@ -247,6 +247,87 @@ func (*OneOfMessages_Foo) isOneOfMessages_Kind() {}
 
 func (*OneOfMessages_Bar) isOneOfMessages_Kind() {}
 
+type OneOfDerived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Choice:
+	//
+	//	*OneOfDerived_Computed
+	//	*OneOfDerived_Plain
+	Choice isOneOfDerived_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *OneOfDerived) Reset() {
+	*x = OneOfDerived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_oneofs_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneOfDerived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneOfDerived) ProtoMessage() {}
+
+func (x *OneOfDerived) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_oneofs_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneOfDerived.ProtoReflect.Descriptor instead.
+func (*OneOfDerived) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_oneofs_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *OneOfDerived) GetChoice() isOneOfDerived_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *OneOfDerived) GetComputed() string {
+	if x, ok := x.GetChoice().(*OneOfDerived_Computed); ok {
+		return x.Computed
+	}
+	return ""
+}
+
+func (x *OneOfDerived) GetPlain() string {
+	if x, ok := x.GetChoice().(*OneOfDerived_Plain); ok {
+		return x.Plain
+	}
+	return ""
+}
+
+type isOneOfDerived_Choice interface {
+	isOneOfDerived_Choice()
+}
+
+type OneOfDerived_Computed struct {
+	Computed string `protobuf:"bytes,1,opt,name=computed,proto3,oneof"`
+}
+
+type OneOfDerived_Plain struct {
+	Plain string `protobuf:"bytes,2,opt,name=plain,proto3,oneof"`
+}
+
+func (*OneOfDerived_Computed) isOneOfDerived_Choice() {}
+
+func (*OneOfDerived_Plain) isOneOfDerived_Choice() {}
+
 var File_gofakeit_test_oneofs_proto protoreflect.FileDescriptor
 
 var file_gofakeit_test_oneofs_proto_rawDesc = []byte{
@@ -271,16 +352,22 @@ var file_gofakeit_test_oneofs_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
 	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x73, 0x48, 0x00, 0x52, 0x03, 0x62, 0x61, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x2a, 0x52, 0x0a, 0x09, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1b,
-	0x0a, 0x17, 0x4f, 0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4f,
-	0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x01,
-	0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
-	0x54, 0x57, 0x4f, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
-	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x64, 0x22, 0x60, 0x0a, 0x0c, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xca, 0xe6, 0x36, 0x0c, 0x5a, 0x0a, 0x27, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x27, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x2a, 0x52, 0x0a, 0x09, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x4f, 0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x4f, 0x4e, 0x45,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x4e, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x45, 0x4e, 0x55,
+	0x4d, 0x5f, 0x54, 0x57, 0x4f, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -296,11 +383,12 @@ func file_gofakeit_test_oneofs_proto_rawDescGZIP() []byte {
 }
 
 var file_gofakeit_test_oneofs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_gofakeit_test_oneofs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_gofakeit_test_oneofs_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_gofakeit_test_oneofs_proto_goTypes = []interface{}{
 	(OneOfEnum)(0),        // 0: gofakeit.test.OneOfEnum
 	(*OneOf)(nil),         // 1: gofakeit.test.OneOf
 	(*OneOfMessages)(nil), // 2: gofakeit.test.OneOfMessages
+	(*OneOfDerived)(nil),  // 3: gofakeit.test.OneOfDerived
 }
 var file_gofakeit_test_oneofs_proto_depIdxs = []int32{
 	0, // 0: gofakeit.test.OneOf.enum:type_name -> gofakeit.test.OneOfEnum
@@ -344,6 +432,18 @@ func file_gofakeit_test_oneofs_proto_init() {
 				return nil
 			}
 		}
+		file_gofakeit_test_oneofs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneOfDerived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_gofakeit_test_oneofs_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*OneOf_Scalar)(nil),
@@ -354,13 +454,17 @@ func file_gofakeit_test_oneofs_proto_init() {
 		(*OneOfMessages_Foo)(nil),
 		(*OneOfMessages_Bar)(nil),
 	}
+	file_gofakeit_test_oneofs_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*OneOfDerived_Computed)(nil),
+		(*OneOfDerived_Plain)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gofakeit_test_oneofs_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/pattern.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Pattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku               string   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Greek             string   `protobuf:"bytes,2,opt,name=greek,proto3" json:"greek,omitempty"`
+	Hex               []byte   `protobuf:"bytes,3,opt,name=hex,proto3" json:"hex,omitempty"`
+	Choice            string   `protobuf:"bytes,4,opt,name=choice,proto3" json:"choice,omitempty"`
+	Unbounded         string   `protobuf:"bytes,5,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	Codes             []string `protobuf:"bytes,6,rep,name=codes,proto3" json:"codes,omitempty"`
+	Validated         string   `protobuf:"bytes,7,opt,name=validated,proto3" json:"validated,omitempty"`
+	ValidatedList     []string `protobuf:"bytes,8,rep,name=validated_list,json=validatedList,proto3" json:"validated_list,omitempty"`
+	ValidatedBoundary string   `protobuf:"bytes,9,opt,name=validated_boundary,json=validatedBoundary,proto3" json:"validated_boundary,omitempty"`
+}
+
+func (x *Pattern) Reset() {
+	*x = Pattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_pattern_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pattern) ProtoMessage() {}
+
+func (x *Pattern) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_pattern_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pattern.ProtoReflect.Descriptor instead.
+func (*Pattern) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_pattern_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pattern) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Pattern) GetGreek() string {
+	if x != nil {
+		return x.Greek
+	}
+	return ""
+}
+
+func (x *Pattern) GetHex() []byte {
+	if x != nil {
+		return x.Hex
+	}
+	return nil
+}
+
+func (x *Pattern) GetChoice() string {
+	if x != nil {
+		return x.Choice
+	}
+	return ""
+}
+
+func (x *Pattern) GetUnbounded() string {
+	if x != nil {
+		return x.Unbounded
+	}
+	return ""
+}
+
+func (x *Pattern) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *Pattern) GetValidated() string {
+	if x != nil {
+		return x.Validated
+	}
+	return ""
+}
+
+func (x *Pattern) GetValidatedList() []string {
+	if x != nil {
+		return x.ValidatedList
+	}
+	return nil
+}
+
+func (x *Pattern) GetValidatedBoundary() string {
+	if x != nil {
+		return x.ValidatedBoundary
+	}
+	return ""
+}
+
+var File_gofakeit_test_pattern_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_pattern_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb1, 0x03, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x28,
+	0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xca, 0xe6, 0x36,
+	0x12, 0x3a, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x2d, 0x5c, 0x64, 0x7b,
+	0x34, 0x7d, 0x24, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x2a, 0x0a, 0x05, 0x67, 0x72, 0x65, 0x65,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xca, 0xe6, 0x36, 0x10, 0x3a, 0x0e, 0x5c,
+	0x70, 0x7b, 0x47, 0x72, 0x65, 0x65, 0x6b, 0x7d, 0x7b, 0x32, 0x2c, 0x34, 0x7d, 0x52, 0x05, 0x67,
+	0x72, 0x65, 0x65, 0x6b, 0x12, 0x23, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x11, 0xca, 0xe6, 0x36, 0x0d, 0x3a, 0x0b, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66,
+	0x5d, 0x7b, 0x38, 0x7d, 0x52, 0x03, 0x68, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xe6, 0x36, 0x0d, 0x3a,
+	0x0b, 0x28, 0x3f, 0x69, 0x29, 0x66, 0x6f, 0x6f, 0x7c, 0x62, 0x61, 0x72, 0x52, 0x06, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xe6, 0x36, 0x0b, 0x3a, 0x09, 0x61,
+	0x2b, 0x62, 0x2a, 0x5b, 0x5e, 0x78, 0x5d, 0x3f, 0x52, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x12, 0xca, 0xe6, 0x36, 0x0e, 0x22, 0x0c, 0x0a, 0x0a, 0x3a, 0x08, 0x5b, 0x41,
+	0x2d, 0x5a, 0x5d, 0x7b, 0x32, 0x7d, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0f, 0xba, 0x48, 0x0c, 0x72, 0x0a, 0x32, 0x08, 0x5e, 0x76, 0x5b, 0x30, 0x2d, 0x39, 0x5d,
+	0x24, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01, 0x0e, 0x22, 0x0c, 0x72, 0x0a,
+	0x32, 0x08, 0x5e, 0x77, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x24, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0x72, 0x07, 0x32, 0x05, 0x5c, 0x62,
+	0x66, 0x6f, 0x6f, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gofakeit_test_pattern_proto_rawDescOnce sync.Once
+	file_gofakeit_test_pattern_proto_rawDescData = file_gofakeit_test_pattern_proto_rawDesc
+)
+
+func file_gofakeit_test_pattern_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_pattern_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_pattern_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_pattern_proto_rawDescData)
+	})
+	return file_gofakeit_test_pattern_proto_rawDescData
+}
+
+var file_gofakeit_test_pattern_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gofakeit_test_pattern_proto_goTypes = []interface{}{
+	(*Pattern)(nil), // 0: gofakeit.test.Pattern
+}
+var file_gofakeit_test_pattern_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_pattern_proto_init() }
+func file_gofakeit_test_pattern_proto_init() {
+	if File_gofakeit_test_pattern_proto != nil {
+		return
+	}
+	file_gofakeit_test_minimal_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_pattern_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_pattern_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_pattern_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_pattern_proto_depIdxs,
+		MessageInfos:      file_gofakeit_test_pattern_proto_msgTypes,
+	}.Build()
+	File_gofakeit_test_pattern_proto = out.File
+	file_gofakeit_test_pattern_proto_rawDesc = nil
+	file_gofakeit_test_pattern_proto_goTypes = nil
+	file_gofakeit_test_pattern_proto_depIdxs = nil
+}
@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gofakeit/test/strings.proto
+
+package test
+
+import (
+	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StringsField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bmp          string   `protobuf:"bytes,1,opt,name=bmp,proto3" json:"bmp,omitempty"`
+	Emoji        string   `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Rtl          string   `protobuf:"bytes,3,opt,name=rtl,proto3" json:"rtl,omitempty"`
+	Combining    string   `protobuf:"bytes,4,opt,name=combining,proto3" json:"combining,omitempty"`
+	Greek        string   `protobuf:"bytes,5,opt,name=greek,proto3" json:"greek,omitempty"`
+	Alphanumeric []string `protobuf:"bytes,6,rep,name=alphanumeric,proto3" json:"alphanumeric,omitempty"`
+	Plain        string   `protobuf:"bytes,7,opt,name=plain,proto3" json:"plain,omitempty"`
+}
+
+func (x *StringsField) Reset() {
+	*x = StringsField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_strings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringsField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringsField) ProtoMessage() {}
+
+func (x *StringsField) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_strings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringsField.ProtoReflect.Descriptor instead.
+func (*StringsField) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_strings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StringsField) GetBmp() string {
+	if x != nil {
+		return x.Bmp
+	}
+	return ""
+}
+
+func (x *StringsField) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *StringsField) GetRtl() string {
+	if x != nil {
+		return x.Rtl
+	}
+	return ""
+}
+
+func (x *StringsField) GetCombining() string {
+	if x != nil {
+		return x.Combining
+	}
+	return ""
+}
+
+func (x *StringsField) GetGreek() string {
+	if x != nil {
+		return x.Greek
+	}
+	return ""
+}
+
+func (x *StringsField) GetAlphanumeric() []string {
+	if x != nil {
+		return x.Alphanumeric
+	}
+	return nil
+}
+
+func (x *StringsField) GetPlain() string {
+	if x != nil {
+		return x.Plain
+	}
+	return ""
+}
+
+type StringsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string            `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Field  string            `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Tag    string            `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *StringsMessage) Reset() {
+	*x = StringsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_strings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringsMessage) ProtoMessage() {}
+
+func (x *StringsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_strings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringsMessage.ProtoReflect.Descriptor instead.
+func (*StringsMessage) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_strings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StringsMessage) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StringsMessage) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StringsMessage) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StringsMessage) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+var File_gofakeit_test_strings_proto protoreflect.FileDescriptor
+
+var file_gofakeit_test_strings_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f,
+	0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xca, 0xe6, 0x36, 0x0a, 0x32, 0x08, 0x08, 0x04, 0x22, 0x04, 0x08,
+	0x05, 0x10, 0x05, 0x52, 0x03, 0x62, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca, 0xe6, 0x36, 0x0c, 0x32, 0x0a, 0x08,
+	0x05, 0x18, 0x03, 0x22, 0x04, 0x08, 0x03, 0x10, 0x03, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x12, 0x22, 0x0a, 0x03, 0x72, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca,
+	0xe6, 0x36, 0x0c, 0x32, 0x0a, 0x08, 0x06, 0x18, 0x02, 0x22, 0x04, 0x08, 0x07, 0x10, 0x07, 0x52,
+	0x03, 0x72, 0x74, 0x6c, 0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca, 0xe6, 0x36, 0x0c, 0x32, 0x0a, 0x08,
+	0x07, 0x18, 0x03, 0x22, 0x04, 0x08, 0x02, 0x10, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0xca, 0xe6, 0x36, 0x11, 0x32, 0x0f, 0x08, 0x08, 0x12, 0x05, 0x47,
+	0x72, 0x65, 0x65, 0x6b, 0x22, 0x04, 0x08, 0x04, 0x10, 0x04, 0x52, 0x05, 0x67, 0x72, 0x65, 0x65,
+	0x6b, 0x12, 0x36, 0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0xca, 0xe6, 0x36, 0x0e, 0x22, 0x0c, 0x0a,
+	0x0a, 0x32, 0x08, 0x08, 0x02, 0x22, 0x04, 0x08, 0x06, 0x10, 0x06, 0x52, 0x0c, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x22,
+	0xf1, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x66, 0x61, 0x6b,
+	0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xe6, 0x36, 0x04,
+	0x32, 0x02, 0x08, 0x02, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xca, 0xe6, 0x36, 0x07, 0x12, 0x05,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x52, 0x03, 0x74, 0x61, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x08, 0x03, 0x22, 0x04, 0x08,
+	0x08, 0x10, 0x08, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_gofakeit_test_strings_proto_rawDescOnce sync.Once
+	file_gofakeit_test_strings_proto_rawDescData = file_gofakeit_test_strings_proto_rawDesc
+)
+
+func file_gofakeit_test_strings_proto_rawDescGZIP() []byte {
+	file_gofakeit_test_strings_proto_rawDescOnce.Do(func() {
+		file_gofakeit_test_strings_proto_rawDescData = protoimpl.X.CompressGZIP(file_gofakeit_test_strings_proto_rawDescData)
+	})
+	return file_gofakeit_test_strings_proto_rawDescData
+}
+
+var file_gofakeit_test_strings_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_gofakeit_test_strings_proto_goTypes = []interface{}{
+	(*StringsField)(nil),   // 0: gofakeit.test.StringsField
+	(*StringsMessage)(nil), // 1: gofakeit.test.StringsMessage
+	nil,                    // 2: gofakeit.test.StringsMessage.LabelsEntry
+}
+var file_gofakeit_test_strings_proto_depIdxs = []int32{
+	2, // 0: gofakeit.test.StringsMessage.labels:type_name -> gofakeit.test.StringsMessage.LabelsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_gofakeit_test_strings_proto_init() }
+func file_gofakeit_test_strings_proto_init() {
+	if File_gofakeit_test_strings_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gofakeit_test_strings_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringsField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_strings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gofakeit_test_strings_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gofakeit_test_strings_proto_goTypes,
+		DependencyIndexes: file_gofakeit_test_strings_proto_depIdxs,
+		MessageInfos:      file_gofakeit_test_strings_proto_msgTypes,
+	}.Build()
+	File_gofakeit_test_strings_proto = out.File
+	file_gofakeit_test_strings_proto_rawDesc = nil
+	file_gofakeit_test_strings_proto_goTypes = nil
+	file_gofakeit_test_strings_proto_depIdxs = nil
+}
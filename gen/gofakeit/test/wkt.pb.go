@@ -10,6 +10,7 @@ import (
 	_ "github.com/rodaine/protogofakeit/gen/gofakeit"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -180,6 +181,155 @@ func (x *WKTDuration) GetTag() *durationpb.Duration {
 	return nil
 }
 
+type WKTAny struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag  *anypb.Any   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	List []*anypb.Any `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *WKTAny) Reset() {
+	*x = WKTAny{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_wkt_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WKTAny) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WKTAny) ProtoMessage() {}
+
+func (x *WKTAny) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_wkt_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WKTAny.ProtoReflect.Descriptor instead.
+func (*WKTAny) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_wkt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WKTAny) GetTag() *anypb.Any {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *WKTAny) GetList() []*anypb.Any {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type WKTAnyUnknown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WKTAnyUnknown) Reset() {
+	*x = WKTAnyUnknown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_wkt_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WKTAnyUnknown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WKTAnyUnknown) ProtoMessage() {}
+
+func (x *WKTAnyUnknown) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_wkt_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WKTAnyUnknown.ProtoReflect.Descriptor instead.
+func (*WKTAnyUnknown) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_wkt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WKTAnyUnknown) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type WKTAnyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WKTAnyValue) Reset() {
+	*x = WKTAnyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gofakeit_test_wkt_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WKTAnyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WKTAnyValue) ProtoMessage() {}
+
+func (x *WKTAnyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_gofakeit_test_wkt_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WKTAnyValue.ProtoReflect.Descriptor instead.
+func (*WKTAnyValue) Descriptor() ([]byte, []int) {
+	return file_gofakeit_test_wkt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WKTAnyValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 var File_gofakeit_test_wkt_proto protoreflect.FileDescriptor
 
 var file_gofakeit_test_wkt_proto_rawDesc = []byte{
@@ -187,38 +337,64 @@ var file_gofakeit_test_wkt_proto_rawDesc = []byte{
 	0x77, 0x6b, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x6f, 0x66, 0x61, 0x6b,
 	0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
 	0x69, 0x74, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x57, 0x4b, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x73, 0x12, 0x3a,
-	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x12, 0x06, 0x7b,
-	0x64, 0x61, 0x74, 0x65, 0x7d, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x62, 0x0a, 0x12, 0x57, 0x4b,
-	0x54, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1a, 0xca, 0xe6, 0x36,
-	0x16, 0x12, 0x14, 0x4a, 0x75, 0x6c, 0x20, 0x31, 0x30, 0x20, 0x32, 0x30, 0x32, 0x33, 0x20, 0x31,
-	0x32, 0x3a, 0x33, 0x34, 0x3a, 0x35, 0x36, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x90,
-	0x01, 0x0a, 0x0b, 0x57, 0x4b, 0x54, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a,
-	0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x75, 0x72, 0x12, 0x45, 0x0a, 0x03, 0x74, 0x61,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x18, 0xca, 0xe6, 0x36, 0x14, 0x12, 0x12, 0x7b, 0x6d, 0x69, 0x6e, 0x75, 0x74,
-	0x65, 0x7d, 0x6d, 0x7b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x7d, 0x73, 0x52, 0x03, 0x74, 0x61,
-	0x67, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x72, 0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66,
-	0x61, 0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65,
-	0x69, 0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x01,
+	0x0a, 0x0c, 0x57, 0x4b, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x39,
+	0x0a, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x73, 0x12, 0x3a, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0c, 0xca, 0xe6, 0x36, 0x08, 0x12, 0x06, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x7d,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x62, 0x0a, 0x12, 0x57, 0x4b, 0x54, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x4c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1a, 0xca, 0xe6, 0x36, 0x16, 0x12, 0x14, 0x4a, 0x75,
+	0x6c, 0x20, 0x31, 0x30, 0x20, 0x32, 0x30, 0x32, 0x33, 0x20, 0x31, 0x32, 0x3a, 0x33, 0x34, 0x3a,
+	0x35, 0x36, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0b, 0x57, 0x4b,
+	0x54, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x44, 0x75, 0x72, 0x12, 0x45, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0xca,
+	0xe6, 0x36, 0x14, 0x12, 0x12, 0x7b, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x7d, 0x6d, 0x7b, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x7d, 0x73, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xf4, 0x01, 0x0a,
+	0x06, 0x57, 0x4b, 0x54, 0x41, 0x6e, 0x79, 0x12, 0x47, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1f, 0xca, 0xe6, 0x36, 0x1b,
+	0x12, 0x19, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x57, 0x4b, 0x54, 0x41, 0x6e, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0xa0, 0x01, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x76, 0xca, 0xe6, 0x36, 0x72, 0x22, 0x70, 0x0a, 0x6e, 0x1a,
+	0x6c, 0x7b, 0x7b, 0x20, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x20, 0x28, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x22, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x4b, 0x54,
+	0x41, 0x6e, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x4b, 0x54,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x20, 0x7d, 0x7d, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x0d, 0x57, 0x4b, 0x54, 0x41, 0x6e, 0x79, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xe6, 0x36, 0x17, 0x12,
+	0x15, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69, 0x74, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a,
+	0x0b, 0x57, 0x4b, 0x54, 0x41, 0x6e, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xca, 0xe6, 0x36,
+	0x08, 0x12, 0x06, 0x66, 0x6f, 0x6f, 0x62, 0x61, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+	0x6f, 0x64, 0x61, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x6f, 0x66, 0x61,
+	0x6b, 0x65, 0x69, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x66, 0x61, 0x6b, 0x65, 0x69,
+	0x74, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -233,25 +409,32 @@ func file_gofakeit_test_wkt_proto_rawDescGZIP() []byte {
 	return file_gofakeit_test_wkt_proto_rawDescData
 }
 
-var file_gofakeit_test_wkt_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_gofakeit_test_wkt_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_gofakeit_test_wkt_proto_goTypes = []interface{}{
 	(*WKTTimestamp)(nil),          // 0: gofakeit.test.WKTTimestamp
 	(*WKTTimestampCustom)(nil),    // 1: gofakeit.test.WKTTimestampCustom
 	(*WKTDuration)(nil),           // 2: gofakeit.test.WKTDuration
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
+	(*WKTAny)(nil),                // 3: gofakeit.test.WKTAny
+	(*WKTAnyUnknown)(nil),         // 4: gofakeit.test.WKTAnyUnknown
+	(*WKTAnyValue)(nil),           // 5: gofakeit.test.WKTAnyValue
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*anypb.Any)(nil),             // 8: google.protobuf.Any
 }
 var file_gofakeit_test_wkt_proto_depIdxs = []int32{
-	3, // 0: gofakeit.test.WKTTimestamp.default_ts:type_name -> google.protobuf.Timestamp
-	3, // 1: gofakeit.test.WKTTimestamp.tag:type_name -> google.protobuf.Timestamp
-	3, // 2: gofakeit.test.WKTTimestampCustom.value:type_name -> google.protobuf.Timestamp
-	4, // 3: gofakeit.test.WKTDuration.default_dur:type_name -> google.protobuf.Duration
-	4, // 4: gofakeit.test.WKTDuration.tag:type_name -> google.protobuf.Duration
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 0: gofakeit.test.WKTTimestamp.default_ts:type_name -> google.protobuf.Timestamp
+	6, // 1: gofakeit.test.WKTTimestamp.tag:type_name -> google.protobuf.Timestamp
+	6, // 2: gofakeit.test.WKTTimestampCustom.value:type_name -> google.protobuf.Timestamp
+	7, // 3: gofakeit.test.WKTDuration.default_dur:type_name -> google.protobuf.Duration
+	7, // 4: gofakeit.test.WKTDuration.tag:type_name -> google.protobuf.Duration
+	8, // 5: gofakeit.test.WKTAny.tag:type_name -> google.protobuf.Any
+	8, // 6: gofakeit.test.WKTAny.list:type_name -> google.protobuf.Any
+	8, // 7: gofakeit.test.WKTAnyUnknown.value:type_name -> google.protobuf.Any
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_gofakeit_test_wkt_proto_init() }
@@ -296,6 +479,42 @@ func file_gofakeit_test_wkt_proto_init() {
 				return nil
 			}
 		}
+		file_gofakeit_test_wkt_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WKTAny); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_wkt_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WKTAnyUnknown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gofakeit_test_wkt_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WKTAnyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -303,7 +522,7 @@ func file_gofakeit_test_wkt_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gofakeit_test_wkt_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,66 @@
+package protogofakeit
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Fake allocates a new message of type T and populates it with fake data via
+// pf. T must be a generated message type (e.g., *pb.User).
+//
+//	user, err := protogofakeit.Fake[*pb.User](pf)
+func Fake[T proto.Message](pf ProtoFaker) (T, error) {
+	msg := newMessage[T]()
+	if err := pf.FakeProto(msg); err != nil {
+		var zero T
+		return zero, err
+	}
+	return msg, nil
+}
+
+// MustFake is the same as [Fake], but panics if an error occurs. It is
+// intended for use in tests and other contexts where the message annotations
+// are known to be valid.
+func MustFake[T proto.Message](pf ProtoFaker) T {
+	msg, err := Fake[T](pf)
+	if err != nil {
+		panic(fmt.Sprintf("failed to fake %T: %v", msg, err))
+	}
+	return msg
+}
+
+// FakeN allocates n new messages of type T, populating each with fake data
+// via pf. If an error occurs, generation stops and the error is returned. An
+// error is also returned if n is negative.
+func FakeN[T proto.Message](pf ProtoFaker, n int) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative message count %d", n)
+	}
+	msgs := make([]T, n)
+	for i := range msgs {
+		msg, err := Fake[T](pf)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// MustFakeN is the same as [FakeN], but panics if an error occurs.
+func MustFakeN[T proto.Message](pf ProtoFaker, n int) []T {
+	msgs, err := FakeN[T](pf, n)
+	if err != nil {
+		var zero T
+		panic(fmt.Sprintf("failed to fake %T: %v", zero, err))
+	}
+	return msgs
+}
+
+// newMessage allocates a new, empty message of type T.
+func newMessage[T proto.Message]() T {
+	var zero T
+	msg, _ := zero.ProtoReflect().Type().New().Interface().(T)
+	return msg
+}
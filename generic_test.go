@@ -0,0 +1,49 @@
+package protogofakeit
+
+import (
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Fake[*test.ScalarStaticTags](initProtoFaker(t))
+	require.NoError(t, err)
+	assert.Equal(t, "foobar", msg.GetString_())
+
+	msg = MustFake[*test.ScalarStaticTags](initProtoFaker(t))
+	assert.Equal(t, "foobar", msg.GetString_())
+
+	invalid, err := Fake[*test.CustomTemplate](initProtoFaker(t))
+	require.Error(t, err)
+	assert.Nil(t, invalid)
+	assert.Panics(t, func() { MustFake[*test.CustomTemplate](initProtoFaker(t)) })
+}
+
+func TestFakeN(t *testing.T) {
+	t.Parallel()
+
+	msgs, err := FakeN[*test.ScalarStaticTags](initProtoFaker(t), 3)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	for _, msg := range msgs {
+		assert.Equal(t, "foobar", msg.GetString_())
+	}
+	assert.NotSame(t, msgs[0], msgs[1])
+
+	msgs = MustFakeN[*test.ScalarStaticTags](initProtoFaker(t), 0)
+	assert.Empty(t, msgs)
+
+	msgs, err = FakeN[*test.ScalarStaticTags](initProtoFaker(t), -1)
+	require.EqualError(t, err, "negative message count -1")
+	assert.Nil(t, msgs)
+
+	invalid, err := FakeN[*test.CustomTemplate](initProtoFaker(t), 3)
+	require.Error(t, err)
+	assert.Nil(t, invalid)
+	assert.Panics(t, func() { MustFakeN[*test.CustomTemplate](initProtoFaker(t), 3) })
+}
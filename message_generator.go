@@ -0,0 +1,82 @@
+package protogofakeit
+
+import (
+	"github.com/brianvoe/gofakeit/v6"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A MessageGenerator populates msg with fake data in place of the default
+// field-by-field behavior. The provided [MessageContext] exposes the
+// underlying faker and allows deferring back to the default behavior for any
+// fields the generator does not populate itself.
+type MessageGenerator func(ctx MessageContext, msg protoreflect.Message) error
+
+// WithMessageGenerator registers gen to construct all messages with the
+// provided fully-qualified name (e.g., "acme.type.Money"). The generator is
+// used wherever the message occurs, including singular, repeated, map value,
+// and oneof fields, as well as the root message passed to
+// [ProtoFaker.FakeProto]. Registering a generator for the same name again
+// replaces the previous one. The well-known types handled as scalars
+// (google.protobuf.Timestamp and google.protobuf.Duration) cannot be
+// overridden.
+func WithMessageGenerator(fullName protoreflect.FullName, gen MessageGenerator) Option {
+	return optionFunc(func(pf *protoFaker) {
+		if pf.msgGens == nil {
+			pf.msgGens = make(map[protoreflect.FullName]MessageGenerator)
+		}
+		pf.msgGens[fullName] = gen
+	})
+}
+
+// MessageContext is provided to a [MessageGenerator], exposing the state of
+// the [ProtoFaker] at the point the message is being generated.
+type MessageContext struct {
+	pf *protoFaker
+	sc scope
+}
+
+// Faker returns the gofakeit.Faker used by the [ProtoFaker].
+func (ctx MessageContext) Faker() *gofakeit.Faker {
+	return ctx.sc.faker
+}
+
+// Depth returns the recursion depth of the message being generated, with the
+// root message at a depth of zero.
+func (ctx MessageContext) Depth() int {
+	return ctx.sc.depth
+}
+
+// Fake populates all fields of msg using the default behavior, ignoring any
+// [MessageGenerator] registered for msg's type. Nested messages still use
+// their registered generators.
+func (ctx MessageContext) Fake(msg protoreflect.Message) error {
+	return ctx.pf.fake(ctx.sc, msg)
+}
+
+// FakeRemaining populates only the fields of msg that are not already set
+// using the default behavior, ignoring any [MessageGenerator] registered for
+// msg's type. A oneof is left untouched if any of its fields are set.
+func (ctx MessageContext) FakeRemaining(msg protoreflect.Message) error {
+	plan := ctx.pf.plan(msg.Descriptor())
+	if plan.err != nil {
+		return plan.err
+	}
+	var errs fieldErrors
+	for _, oneof := range plan.oneofs {
+		if msg.WhichOneof(oneof.desc) != nil {
+			continue
+		}
+		if !ctx.pf.collect(&errs, ctx.pf.fakeOneof(ctx.sc, msg, oneof)) {
+			return errs.err()
+		}
+	}
+	for _, field := range plan.fields {
+		if msg.Has(field.desc) {
+			continue
+		}
+		if !ctx.pf.collect(&errs, ctx.pf.fakeField(ctx.sc, msg, field)) {
+			break
+		}
+	}
+	return errs.err()
+}
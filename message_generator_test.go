@@ -0,0 +1,109 @@
+package protogofakeit
+
+import (
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestWithMessageGenerator(t *testing.T) {
+	t.Parallel()
+
+	customName := (&test.Custom{}).ProtoReflect().Descriptor().FullName()
+
+	t.Run("occurrences", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		pf := initProtoFaker(t,
+			WithMessageGenerator(customName, func(_ MessageContext, msg protoreflect.Message) error {
+				calls++
+				msg.Set(msg.Descriptor().Fields().ByName("value"), protoreflect.ValueOfString("custom"))
+				return nil
+			}),
+		)
+
+		for range 10 {
+			msg := &test.CustomContainer{}
+			require.NoError(t, pf.FakeProto(msg))
+			assertCustom(t, msg.GetSingular())
+			sliceInDefault(t, msg.GetList())
+			for _, el := range msg.GetList() {
+				assertCustom(t, el)
+			}
+			mapInDefault(t, msg.GetMap())
+			for _, v := range msg.GetMap() {
+				assertCustom(t, v)
+			}
+			if opt := msg.GetOption(); opt != nil {
+				assertCustom(t, opt)
+			}
+		}
+		assert.Positive(t, calls)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t,
+			WithMessageGenerator(customName, func(ctx MessageContext, msg protoreflect.Message) error {
+				assert.Zero(t, ctx.Depth())
+				msg.Set(msg.Descriptor().Fields().ByName("value"), protoreflect.ValueOfString("custom"))
+				return nil
+			}),
+		)
+		msg := &test.Custom{}
+		require.NoError(t, pf.FakeProto(msg))
+		assertCustom(t, msg)
+	})
+
+	t.Run("fake_remaining", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t,
+			WithMessageGenerator(customName, func(ctx MessageContext, msg protoreflect.Message) error {
+				msg.Set(msg.Descriptor().Fields().ByName("value"), protoreflect.ValueOfString("custom"))
+				return ctx.FakeRemaining(msg)
+			}),
+		)
+		msg := &test.Custom{}
+		require.NoError(t, pf.FakeProto(msg))
+		assert.Equal(t, "custom", msg.GetValue())
+	})
+
+	t.Run("fake", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t,
+			WithMessageGenerator(customName, func(ctx MessageContext, msg protoreflect.Message) error {
+				if err := ctx.Fake(msg); err != nil {
+					return err
+				}
+				msg.Set(msg.Descriptor().Fields().ByName("other"), protoreflect.ValueOfInt32(-1))
+				return nil
+			}),
+		)
+		msg := &test.Custom{}
+		require.NoError(t, pf.FakeProto(msg))
+		assert.NotEmpty(t, msg.GetValue())
+		assert.Equal(t, int32(-1), msg.GetOther())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t,
+			WithMessageGenerator(customName, func(MessageContext, protoreflect.Message) error {
+				return assert.AnError
+			}),
+		)
+		err := pf.FakeProto(&test.CustomContainer{})
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func assertCustom(tb testing.TB, msg *test.Custom) {
+	tb.Helper()
+	if assert.NotNil(tb, msg) {
+		assert.Equal(tb, "custom", msg.GetValue())
+		assert.Zero(tb, msg.GetOther())
+	}
+}
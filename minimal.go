@@ -0,0 +1,187 @@
+package protogofakeit
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field numbers of the options identifying required fields and oneofs,
+// matched by number so that their definitions need not be linked into the
+// binary.
+const (
+	// fieldBehaviorNumber is the google.api.field_behavior extension of
+	// google.protobuf.FieldOptions.
+	fieldBehaviorNumber protowire.Number = 1052
+	// fieldBehaviorRequired is the value of google.api.FieldBehavior.REQUIRED.
+	fieldBehaviorRequired = 2
+	// validateNumber is the buf.validate.field and buf.validate.oneof
+	// extensions of google.protobuf.FieldOptions and OneofOptions.
+	validateNumber protowire.Number = 1159
+	// validateFieldRequiredNumber is buf.validate.FieldRules.required.
+	validateFieldRequiredNumber protowire.Number = 25
+	// validateOneofRequiredNumber is buf.validate.OneofRules.required.
+	validateOneofRequiredNumber protowire.Number = 1
+)
+
+// WithMinimalMessages produces the smallest valid message instead of
+// populating every field. Only required fields are generated, which are:
+//
+//   - proto2 required fields
+//   - fields with the google.api.field_behavior option set to REQUIRED
+//   - fields with the buf.validate.field option's required rule set
+//
+// Oneofs with the buf.validate.oneof option's required rule set have a random
+// case generated, and are otherwise left unset. Lists and maps with a minimum
+// size (a len or range annotation with a non-zero min) are also generated,
+// with exactly that many elements or entries. Required lists and maps without
+// a minimum size have a single element or entry. Required message fields are
+// themselves generated minimally.
+func WithMinimalMessages() Option {
+	return optionFunc(func(pf *protoFaker) { pf.minimal = true })
+}
+
+// fakeMinimal populates only the required fields and oneofs of msg.
+func (pf *protoFaker) fakeMinimal(sc scope, msg protoreflect.Message, plan *messagePlan) error {
+	var errs fieldErrors
+	for _, oneof := range plan.oneofs {
+		if !oneof.required {
+			continue
+		}
+		var choices []*fieldPlan
+		for _, field := range oneof.fields {
+			if !field.gen.GetSkip() {
+				choices = append(choices, field)
+			}
+		}
+		if len(choices) == 0 {
+			continue
+		}
+		idx := sc.field(string(oneof.desc.Name())).faker.Rand.Intn(len(choices))
+		if !pf.collect(&errs, pf.fakeField(sc, msg, choices[idx])) {
+			return errs.err()
+		}
+	}
+	for _, field := range plan.fields {
+		if !field.required && minimumSize(field.gen, field.desc) == 0 {
+			continue
+		}
+		if !pf.collect(&errs, pf.fakeField(sc, msg, field)) {
+			break
+		}
+	}
+	return errs.err()
+}
+
+// minimumSize returns the minimum size annotated on the list or map field
+// desc, or zero if none is set.
+func minimumSize(gen *generatorPlan, desc protoreflect.FieldDescriptor) int {
+	switch {
+	case desc.IsList():
+		rep := gen.GetRepeated()
+		return sizeMinimum(rep, rep.GetSize() != nil)
+	case desc.IsMap():
+		mapGen := gen.GetMap()
+		return sizeMinimum(mapGen, mapGen.GetSize() != nil)
+	default:
+		return 0
+	}
+}
+
+func sizeMinimum(msg sized, hasSizeOneof bool) int {
+	if !hasSizeOneof {
+		return 0
+	}
+	if rng := msg.GetRange(); rng != nil {
+		return int(rng.GetMin())
+	}
+	return int(msg.GetLen())
+}
+
+// minimalSize is the size of a generated list or map of minimal messages,
+// which is only generated if it is required or has a minimum size.
+func minimalSize(msg sized, hasSizeOneof bool) int {
+	return max(sizeMinimum(msg, hasSizeOneof), 1)
+}
+
+// isRequired reports whether the field desc is required by its cardinality or
+// options.
+func isRequired(desc protoreflect.FieldDescriptor) bool {
+	if desc.Cardinality() == protoreflect.Required {
+		return true
+	}
+	required := false
+	opts := marshalOptions(desc.Options())
+	rangeFieldValues(opts, fieldBehaviorNumber, func(typ protowire.Type, val []byte) {
+		switch typ {
+		case protowire.VarintType:
+			v, _ := protowire.ConsumeVarint(val)
+			required = required || v == fieldBehaviorRequired
+		case protowire.BytesType:
+			packed, _ := protowire.ConsumeBytes(val)
+			for len(packed) > 0 {
+				v, n := protowire.ConsumeVarint(packed)
+				if n < 0 {
+					break
+				}
+				required = required || v == fieldBehaviorRequired
+				packed = packed[n:]
+			}
+		default:
+		}
+	})
+	return required || hasRequiredRule(opts, validateFieldRequiredNumber)
+}
+
+// isRequiredOneof reports whether the oneof desc is required by its options.
+func isRequiredOneof(desc protoreflect.OneofDescriptor) bool {
+	return hasRequiredRule(marshalOptions(desc.Options()), validateOneofRequiredNumber)
+}
+
+// hasRequiredRule reports whether the buf.validate rules within the encoded
+// options opts have the boolean field numbered num set to true.
+func hasRequiredRule(opts []byte, num protowire.Number) bool {
+	required := false
+	rangeFieldValues(opts, validateNumber, func(typ protowire.Type, val []byte) {
+		if typ != protowire.BytesType {
+			return
+		}
+		rules, _ := protowire.ConsumeBytes(val)
+		rangeFieldValues(rules, num, func(typ protowire.Type, val []byte) {
+			if typ == protowire.VarintType {
+				v, _ := protowire.ConsumeVarint(val)
+				required = v != 0
+			}
+		})
+	})
+	return required
+}
+
+// marshalOptions encodes opts, such that options are found the same whether
+// or not their extensions are linked into the binary.
+func marshalOptions(opts proto.Message) []byte {
+	if opts == nil {
+		return nil
+	}
+	out, _ := proto.MarshalOptions{AllowPartial: true}.Marshal(opts)
+	return out
+}
+
+// rangeFieldValues calls fn with the wire type and encoded value of each
+// occurrence of the field numbered num within the encoded message msg.
+func rangeFieldValues(msg []byte, num protowire.Number, fn func(typ protowire.Type, val []byte)) {
+	for len(msg) > 0 {
+		fieldNum, typ, tagLen := protowire.ConsumeTag(msg)
+		if tagLen < 0 {
+			return
+		}
+		valLen := protowire.ConsumeFieldValue(fieldNum, typ, msg[tagLen:])
+		if valLen < 0 {
+			return
+		}
+		if fieldNum == num {
+			fn(typ, msg[tagLen:tagLen+valLen])
+		}
+		msg = msg[tagLen+valLen:]
+	}
+}
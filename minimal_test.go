@@ -0,0 +1,50 @@
+package protogofakeit
+
+import (
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMinimalMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("required", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithMinimalMessages())
+		for range 10 {
+			msg := &test.Minimal{}
+			require.NoError(t, pf.FakeProto(msg))
+			assert.NotNil(t, msg.Name)
+			assert.NotNil(t, msg.Id)
+			assert.NotNil(t, msg.Email)
+			assert.Len(t, msg.GetTags(), 2)
+			assert.Len(t, msg.GetLabels(), 1)
+			assert.NotNil(t, msg.GetChild().Value)
+			assert.NotNil(t, msg.GetKind())
+
+			assert.Nil(t, msg.Nickname)
+			assert.Empty(t, msg.GetAliases())
+			assert.Empty(t, msg.GetAttributes())
+			assert.Nil(t, msg.GetChild().Note)
+			assert.Nil(t, msg.GetOtherChild())
+			assert.Nil(t, msg.GetOptionalKind())
+		}
+	})
+
+	t.Run("proto3", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.CustomContainer{}
+		require.NoError(t, initProtoFaker(t, WithMinimalMessages()).FakeProto(msg))
+		assert.Nil(t, msg.GetSingular())
+		assert.Empty(t, msg.GetList())
+		assert.Empty(t, msg.GetMap())
+		assert.Nil(t, msg.GetChoice())
+
+		ranged := &test.RepeatedRange{}
+		require.NoError(t, initProtoFaker(t, WithMinimalMessages()).FakeProto(ranged))
+		assert.Len(t, ranged.GetScalars(), 1)
+	})
+}
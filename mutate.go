@@ -0,0 +1,383 @@
+package protogofakeit
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxMutationAttempts bounds the number of times a singular field is
+// regenerated in an attempt to produce a value different from its current one.
+const maxMutationAttempts = 8
+
+// Mutate applies n random mutations via pf to the already-populated msg, each
+// regenerating one randomly chosen field within msg or any of its populated
+// sub-messages (including list elements and map values) using the same
+// generators as FakeProto. Depending on the field, a mutation:
+//
+//   - replaces the value of a singular field with a different one
+//   - adds, removes, or replaces an element of a list
+//   - adds or deletes an entry of a map
+//   - switches a oneof to a different case, or clears it
+//   - clears or regenerates a sub-message
+//
+// Fields derived from others (via copy_from, cel, or templates referencing
+// fields) are not mutated directly. Instead, after each mutation they are
+// recomputed within the mutated message and the messages containing it, whose
+// ensure predicates must then still hold; otherwise, the mutation is undone
+// and retried.
+//
+// Messages with a registered MessageGenerator are mutated as a whole rather
+// than recursed into. Mutate does nothing if msg has no fields to mutate. pf
+// must be created by [New], or an error is returned.
+func Mutate(pf ProtoFaker, msg proto.Message, n int) error {
+	base, err := implementation(pf)
+	if err != nil {
+		return err
+	}
+	return base.mutateN(msg, n)
+}
+
+func (pf *protoFaker) mutateN(msg proto.Message, n int) error {
+	if pf.err != nil {
+		return pf.err
+	}
+	sc := pf.newScope(pf.types)
+	var errs fieldErrors
+	for i := range n {
+		ok, err := pf.mutateEnsured(sc.index(i), msg)
+		if !pf.collect(&errs, err) || !ok {
+			break
+		}
+	}
+	return errs.err()
+}
+
+// mutateEnsured applies a single mutation to msg, retrying it until the
+// ensure predicates of the messages containing the mutated field hold. If they
+// never do, msg is restored. It reports false if msg has no fields to mutate.
+func (pf *protoFaker) mutateEnsured(sc scope, msg proto.Message) (bool, error) {
+	orig := proto.Clone(msg)
+	var failed string
+	var failedMsg protoreflect.FullName
+	for attempt := range maxEnsureAttempts {
+		attemptScope := sc
+		if attempt > 0 {
+			proto.Reset(msg)
+			proto.Merge(msg, orig)
+			attemptScope = sc.derive("ensure#" + strconv.Itoa(attempt))
+		}
+		root := &mutationMessage{msg: msg.ProtoReflect(), wrap: func(err error) error { return err }}
+		sites, err := pf.mutationSites(nil, root)
+		if err != nil || len(sites) == 0 {
+			return false, err
+		}
+		site := sites[attemptScope.faker.Rand.Intn(len(sites))]
+		attemptScope.depth = site.depth
+		if err = pf.mutate(attemptScope, site); err != nil {
+			return true, err
+		}
+		var in *mutationMessage
+		if in, failed, err = pf.settle(attemptScope, site.mutationMessage); err != nil || in == nil {
+			return true, err
+		}
+		failedMsg = in.msg.Descriptor().FullName()
+	}
+	proto.Reset(msg)
+	proto.Merge(msg, orig)
+	return true, fmt.Errorf("%s: failed to satisfy ensure %q after %d attempts",
+		failedMsg, failed, maxEnsureAttempts)
+}
+
+// settle recomputes the derived fields of m and each message containing it,
+// innermost first, then checks their ensure predicates. It returns the first
+// message left unsatisfied, if any, along with its failed predicate.
+func (pf *protoFaker) settle(sc scope, m *mutationMessage) (*mutationMessage, string, error) {
+	for ; m != nil; m = m.parent {
+		plan := pf.plan(m.msg.Descriptor())
+		sc.msg = m.msg
+		for _, oneof := range plan.oneofs {
+			set := m.msg.WhichOneof(oneof.desc)
+			if set == nil || !plan.byName[set.Name()].derived() {
+				continue
+			}
+			if err := pf.replaceField(sc.field(string(set.Name())), m.msg, plan.byName[set.Name()]); err != nil {
+				return nil, "", m.wrap(err)
+			}
+		}
+		for _, field := range plan.fields {
+			if !field.derived() {
+				continue
+			}
+			if err := pf.replaceField(sc.field(string(field.desc.Name())), m.msg, field); err != nil {
+				return nil, "", m.wrap(err)
+			}
+		}
+		failed, err := unsatisfied(plan.ensure, m.msg)
+		if err != nil {
+			return nil, "", m.wrap(err)
+		} else if failed != "" {
+			return m, failed, nil
+		}
+	}
+	return nil, "", nil
+}
+
+// mutationMessage is a message within the mutated message.
+type mutationMessage struct {
+	msg   protoreflect.Message
+	depth int
+	// wrap prefixes the path of the message to any errors mutating it.
+	wrap func(error) error
+	// parent is the message containing msg, or nil for the mutated message.
+	parent *mutationMessage
+}
+
+// mutationSite is a field or oneof of a message that may be mutated.
+type mutationSite struct {
+	*mutationMessage
+	field *fieldPlan
+	oneof *oneofPlan
+}
+
+// mutationSites appends the mutable fields and oneofs of m and its populated
+// sub-messages to sites.
+func (pf *protoFaker) mutationSites(sites []mutationSite, m *mutationMessage) ([]mutationSite, error) {
+	plan := pf.plan(m.msg.Descriptor())
+	if plan.err != nil {
+		return sites, plan.err
+	}
+	var err error
+	for _, oneof := range plan.oneofs {
+		for _, field := range oneof.fields {
+			if !field.gen.GetSkip() && !field.derived() {
+				sites = append(sites, mutationSite{mutationMessage: m, oneof: oneof})
+				break
+			}
+		}
+		if set := m.msg.WhichOneof(oneof.desc); set != nil {
+			if sites, err = pf.nestedMutationSites(sites, m, plan.byName[set.Name()]); err != nil {
+				return sites, err
+			}
+		}
+	}
+	for _, field := range plan.fields {
+		if field.gen.GetSkip() || field.derived() {
+			continue
+		}
+		sites = append(sites, mutationSite{mutationMessage: m, field: field})
+		if sites, err = pf.nestedMutationSites(sites, m, field); err != nil {
+			return sites, err
+		}
+	}
+	return sites, nil
+}
+
+// nestedMutationSites appends the mutation sites of the populated messages
+// held by field on m.
+func (pf *protoFaker) nestedMutationSites(
+	sites []mutationSite,
+	m *mutationMessage,
+	field *fieldPlan,
+) ([]mutationSite, error) {
+	desc := field.desc
+	target := desc
+	if desc.IsMap() {
+		target = desc.MapValue()
+	}
+	if field.gen.GetSkip() || field.derived() || !isFillable(target) || !m.msg.Has(desc) ||
+		m.depth+1 >= pf.maxDepth || pf.msgGens[target.Message().FullName()] != nil {
+		return sites, nil
+	}
+
+	name := string(desc.Name())
+	nested := func(msg protoreflect.Message, wrap func(error) error) *mutationMessage {
+		return &mutationMessage{msg: msg, depth: m.depth + 1, wrap: wrap, parent: m}
+	}
+	var err error
+	switch {
+	case desc.IsMap():
+		mapVal := m.msg.Mutable(desc).Map()
+		for _, key := range sortedMapKeys(mapVal) {
+			wrap := func(err error) error { return m.wrap(prefixPathError(name, keyPathError(key, err))) }
+			if sites, err = pf.mutationSites(sites, nested(mapVal.Mutable(key).Message(), wrap)); err != nil {
+				return sites, err
+			}
+		}
+	case desc.IsList():
+		list := m.msg.Mutable(desc).List()
+		for i := range list.Len() {
+			wrap := func(err error) error { return m.wrap(prefixPathError(name, indexPathError(i, err))) }
+			if sites, err = pf.mutationSites(sites, nested(list.Get(i).Message(), wrap)); err != nil {
+				return sites, err
+			}
+		}
+	default:
+		wrap := func(err error) error { return m.wrap(prefixPathError(name, err)) }
+		return pf.mutationSites(sites, nested(m.msg.Mutable(desc).Message(), wrap))
+	}
+	return sites, nil
+}
+
+func (pf *protoFaker) mutate(sc scope, site mutationSite) error {
+	var err error
+	if site.oneof != nil {
+		err = pf.mutateOneof(sc, site.msg, site.oneof)
+	} else {
+		err = pf.mutateField(sc, site.msg, site.field)
+	}
+	if err != nil {
+		return site.wrap(err)
+	}
+	return nil
+}
+
+// mutateOneof switches oneof on msg to a different case or, if a case is
+// set, possibly clears it. Derived cases are never switched to.
+func (pf *protoFaker) mutateOneof(sc scope, msg protoreflect.Message, oneof *oneofPlan) error {
+	set := msg.WhichOneof(oneof.desc)
+	choices := make([]*fieldPlan, 0, len(oneof.fields))
+	for _, field := range oneof.fields {
+		if !field.gen.GetSkip() && !field.derived() && field.desc != set {
+			choices = append(choices, field)
+		}
+	}
+	n := len(choices)
+	if set != nil {
+		n++
+	}
+	idx := sc.field(string(oneof.desc.Name())).faker.Rand.Intn(n)
+	if idx == len(choices) {
+		msg.Clear(set)
+		return nil
+	}
+	field := choices[idx]
+	return pf.replaceField(sc.field(string(field.desc.Name())), msg, field)
+}
+
+func (pf *protoFaker) mutateField(sc scope, msg protoreflect.Message, field *fieldPlan) error {
+	desc, gen := field.desc, field.gen
+	sc = sc.field(string(desc.Name()))
+	sc.msg = msg
+	var err error
+	switch {
+	case desc.IsMap():
+		err = pf.mutateMap(sc, msg.Mutable(desc).Map(), desc, gen)
+	case desc.IsList():
+		err = pf.mutateList(sc, msg.Mutable(desc).List(), desc, gen)
+	case isFillable(desc) && msg.Has(desc) && sc.faker.Rand.Intn(2) == 0:
+		msg.Clear(desc)
+	default:
+		prev := msg.Get(desc)
+		for i := range maxMutationAttempts {
+			if err := pf.replaceField(sc.index(i), msg, field); err != nil {
+				return err
+			}
+			if !msg.Get(desc).Equal(prev) {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return fieldPathError(desc, gen, err)
+	}
+	return nil
+}
+
+// mutateList adds, removes, or replaces a random element of list.
+func (pf *protoFaker) mutateList(
+	sc scope,
+	list protoreflect.List,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) error {
+	const (
+		add = iota
+		remove
+		replace
+	)
+	op := add
+	if list.Len() > 0 {
+		op = sc.faker.Rand.Intn(replace + 1)
+	}
+
+	switch op {
+	case remove:
+		idx := sc.faker.Rand.Intn(list.Len())
+		for i := idx; i < list.Len()-1; i++ {
+			list.Set(i, list.Get(i+1))
+		}
+		list.Truncate(list.Len() - 1)
+		return nil
+	case replace:
+		idx := sc.faker.Rand.Intn(list.Len())
+		val, err := pf.fakeFieldValue(sc.index(idx), list.NewElement(), desc, gen.element, true)
+		if err != nil {
+			return indexPathError(idx, err)
+		}
+		if val.IsValid() {
+			list.Set(idx, val)
+		}
+		return nil
+	default:
+		idx := sc.faker.Rand.Intn(list.Len() + 1)
+		val, err := pf.fakeFieldValue(sc.index(idx), list.NewElement(), desc, gen.element, true)
+		if err != nil {
+			return indexPathError(idx, err)
+		}
+		if !val.IsValid() {
+			return nil
+		}
+		list.Append(val)
+		for i := list.Len() - 1; i > idx; i-- {
+			list.Set(i, list.Get(i-1))
+		}
+		list.Set(idx, val)
+		return nil
+	}
+}
+
+// mutateMap adds a new entry to mapVal or deletes a random existing one. If
+// no key absent from mapVal is generated, nothing is added.
+func (pf *protoFaker) mutateMap(
+	sc scope,
+	mapVal protoreflect.Map,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) error {
+	if mapVal.Len() > 0 && sc.faker.Rand.Intn(2) == 0 {
+		keys := sortedMapKeys(mapVal)
+		mapVal.Clear(keys[sc.faker.Rand.Intn(len(keys))])
+		return nil
+	}
+
+	entry := sc.index(mapVal.Len())
+	kDesc, vDesc := desc.MapKey(), desc.MapValue()
+	key := kDesc.Default()
+	for i := 0; !gen.key.GetSkip() && i < maxMutationAttempts; i++ {
+		keyScope := entry
+		if i > 0 {
+			keyScope = entry.derive("key#" + strconv.Itoa(i))
+		}
+		var err error
+		if key, err = pf.fakeScalar(keyScope, kDesc, gen.key); err != nil {
+			return err
+		}
+		if !mapVal.Has(key.MapKey()) {
+			break
+		}
+	}
+	if mapVal.Has(key.MapKey()) {
+		return nil
+	}
+	val, err := pf.fakeFieldValue(entry, mapVal.NewValue(), vDesc, gen.value, true)
+	if err != nil {
+		return keyPathError(key.MapKey(), err)
+	}
+	if val.IsValid() {
+		mapVal.Set(key.MapKey(), val)
+	}
+	return nil
+}
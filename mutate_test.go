@@ -0,0 +1,170 @@
+package protogofakeit
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMutate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		msg := &test.Custom{}
+		require.NoError(t, pf.FakeProto(msg))
+		value, other := msg.GetValue(), msg.GetOther()
+		require.NoError(t, Mutate(pf, msg, 1))
+		changed := 0
+		if msg.GetValue() != value {
+			changed++
+		}
+		if msg.GetOther() != other {
+			changed++
+		}
+		assert.Equal(t, 1, changed)
+	})
+
+	t.Run("constants", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		msg := &test.ScalarStaticTags{}
+		require.NoError(t, pf.FakeProto(msg))
+		orig := proto.Clone(msg)
+		require.NoError(t, Mutate(pf, msg, 10))
+		assert.True(t, proto.Equal(orig, msg))
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		lengths := map[int]bool{}
+		for range 50 {
+			msg := &test.RepeatedLength{}
+			require.NoError(t, pf.FakeProto(msg))
+			require.NoError(t, Mutate(pf, msg, 1))
+			lengths[len(msg.GetScalars())] = true
+		}
+		assert.Equal(t, map[int]bool{2: true, 3: true, 4: true}, lengths)
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		lengths := map[int]bool{}
+		for range 50 {
+			msg := &test.MapLength{}
+			require.NoError(t, pf.FakeProto(msg))
+			require.NoError(t, Mutate(pf, msg, 1))
+			lengths[len(msg.GetValues())] = true
+		}
+		assert.Equal(t, map[int]bool{2: true, 4: true}, lengths)
+	})
+
+	t.Run("map_keys", func(t *testing.T) {
+		t.Parallel()
+		overlay := `fields: {gofakeit.test.MapLength.values: {map: {key: {tag: "{randomstring:[a,b,c]}"}}}}`
+		pf := initProtoFaker(t, WithOverlay(strings.NewReader(overlay)))
+		for range 20 {
+			msg := &test.MapLength{}
+			require.NoError(t, pf.FakeProto(msg))
+			orig := maps.Clone(msg.GetValues())
+			require.NoError(t, Mutate(pf, msg, 1))
+			assert.InDelta(t, len(orig), len(msg.GetValues()), 1)
+			for key, val := range msg.GetValues() {
+				if prev, ok := orig[key]; ok {
+					assert.Equal(t, prev, val, "existing entries are not replaced")
+				}
+			}
+		}
+	})
+
+	t.Run("derived", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		for range 10 {
+			msg := &test.Invoice{}
+			require.NoError(t, pf.FakeProto(msg))
+			require.NoError(t, Mutate(pf, msg, 20))
+			var total float64
+			prices := make([]float64, 0, len(msg.GetItems()))
+			for _, item := range msg.GetItems() {
+				total += item.GetPrice()
+				prices = append(prices, item.GetPrice())
+			}
+			assert.InDelta(t, total, msg.GetTotal(), 1e-9)
+			assert.Equal(t, prices, msg.GetPrices())
+			assert.Equal(t, int64(len(msg.GetItems())), msg.GetCount())
+			assert.NotEmpty(t, msg.GetItems(), "ensure holds")
+			assert.True(t, msg.GetEndTime().AsTime().After(msg.GetStartTime().AsTime()), "ensure holds")
+		}
+	})
+
+	t.Run("unsatisfiable", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.Unsatisfiable{Value: 7}
+		err := Mutate(initProtoFaker(t), msg, 1)
+		require.ErrorContains(t, err, "failed to satisfy ensure")
+		assert.Equal(t, uint32(7), msg.GetValue(), "restored")
+	})
+
+	t.Run("derived_oneofs", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		msg := &test.OneOfDerived{Choice: &test.OneOfDerived_Plain{Plain: "foo"}}
+		for range 20 {
+			require.NoError(t, Mutate(pf, msg, 1))
+			assert.Empty(t, msg.GetComputed())
+		}
+	})
+
+	t.Run("oneofs", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithMaxDepth(2))
+		for range 10 {
+			msg := &test.OneOf{Fields: &test.OneOf_Scalar{Scalar: "foo"}}
+			require.NoError(t, Mutate(pf, msg, 1))
+			assert.NotEqual(t, "foo", msg.GetScalar())
+		}
+	})
+
+	t.Run("messages", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		for range 10 {
+			msg := &test.CustomContainer{}
+			require.NoError(t, pf.FakeProto(msg))
+			require.NoError(t, Mutate(pf, msg, 20))
+		}
+	})
+
+	t.Run("skipped", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.MessageSkipped{}
+		require.NoError(t, Mutate(initProtoFaker(t), msg, 1))
+		assert.Nil(t, msg.GetSkipped())
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		t.Parallel()
+		msgs := make([]*test.CustomContainer, 2)
+		for i := range msgs {
+			pf := initProtoFaker(t, WithStableSeeding(), withSeed(42))
+			msgs[i] = &test.CustomContainer{}
+			require.NoError(t, pf.FakeProto(msgs[i]))
+			require.NoError(t, Mutate(pf, msgs[i], 5))
+		}
+		assert.True(t, proto.Equal(msgs[0], msgs[1]))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		require.Error(t, Mutate(initProtoFaker(t), &test.Invalid{}, 1))
+	})
+}
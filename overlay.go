@@ -0,0 +1,263 @@
+package protogofakeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gopkg.in/yaml.v3"
+)
+
+// WithOverlay loads a gofakeit.Overlay from r, configuring generators for
+// fields without modifying their proto source. The overlay may be encoded as
+// YAML, JSON, or the protobuf text format:
+//
+//	fields:
+//	  google.type.PostalAddress.region_code:
+//	    tag: "{countryabr}"
+//	  "acme.*.email":
+//	    tag: "{email}"
+//	rules:
+//	  - name: "_id$"
+//	    kinds: [string]
+//	    package: "acme.billing.*"
+//	    generate: {tag: "{uuid}"}
+//
+// Keys are either fully-qualified field names or glob patterns (using the
+// syntax of [path.Match]) matched against them. An overlay may also include
+// rules, applied the same as [WithRules]. An overlay generator is merged
+// over any annotation on the field, with glob patterns applied from least to
+// most specific (shortest to longest) followed by an exact match. Applying
+// multiple overlays merges them, with later overlays replacing earlier entries
+// of the same key.
+//
+// Overlay keys are validated against the registered descriptors once all
+// options are applied. Any error reading, parsing, or validating the overlay
+// is returned from every call to [ProtoFaker.FakeProto].
+func WithOverlay(r io.Reader) Option {
+	return optionFunc(func(pf *protoFaker) {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			pf.addErr(fmt.Errorf("failed to read overlay: %w", err))
+			return
+		}
+		pf.addOverlay(parseOverlay(data))
+	})
+}
+
+// WithOverlayFile loads a gofakeit.Overlay from the file at path. The encoding
+// is determined by the file extension: ".json" for JSON, ".yaml" or ".yml" for
+// YAML, and ".txtpb", ".textproto", or ".pbtxt" for the protobuf text format.
+// Any other extension is handled the same as [WithOverlay].
+func WithOverlayFile(path string) Option {
+	return optionFunc(func(pf *protoFaker) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			pf.addErr(fmt.Errorf("failed to read overlay: %w", err))
+			return
+		}
+		var overlay *pb.Overlay
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".json":
+			overlay, err = parseOverlayJSON(data)
+		case ".yaml", ".yml":
+			overlay, err = parseOverlayYAML(data)
+		case ".txtpb", ".textproto", ".pbtxt":
+			overlay, err = parseOverlayText(data)
+		default:
+			overlay, err = parseOverlay(data)
+		}
+		if err != nil {
+			err = fmt.Errorf("invalid overlay file %q: %w", path, err)
+		}
+		pf.addOverlay(overlay, err)
+	})
+}
+
+// overlay is the resolved form of one or more gofakeit.Overlay messages.
+type overlay struct {
+	exact map[protoreflect.FullName]*pb.Generator
+	globs map[string]*pb.Generator
+	// patterns holds the keys of globs, ordered from least to most specific.
+	patterns []string
+}
+
+func (o *overlay) add(src *pb.Overlay) error {
+	if o.exact == nil {
+		o.exact = make(map[protoreflect.FullName]*pb.Generator)
+		o.globs = make(map[string]*pb.Generator)
+	}
+	var errs []error
+	for key, gen := range src.GetFields() {
+		if !isGlob(key) {
+			o.exact[protoreflect.FullName(key)] = gen
+			continue
+		}
+		if _, err := path.Match(key, ""); err != nil {
+			errs = append(errs, fmt.Errorf("invalid overlay pattern %q: %w", key, err))
+			continue
+		}
+		if _, ok := o.globs[key]; !ok {
+			o.patterns = append(o.patterns, key)
+		}
+		o.globs[key] = gen
+	}
+	sort.Slice(o.patterns, func(i, j int) bool {
+		a, b := o.patterns[i], o.patterns[j]
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	})
+	return errors.Join(errs...)
+}
+
+// apply merges any matching overlay generators over gen, returning the
+// result. If no overlay entries match, gen is returned as-is.
+func (o *overlay) apply(name protoreflect.FullName, gen *pb.Generator) *pb.Generator {
+	if o == nil {
+		return gen
+	}
+	var out *pb.Generator
+	merge := func(src *pb.Generator) {
+		if out == nil {
+			out = &pb.Generator{}
+			proto.Merge(out, gen)
+		}
+		proto.Merge(out, src)
+	}
+	for _, pattern := range o.patterns {
+		if ok, _ := path.Match(pattern, string(name)); ok {
+			merge(o.globs[pattern])
+		}
+	}
+	if src, ok := o.exact[name]; ok {
+		merge(src)
+	}
+	if out == nil {
+		return gen
+	}
+	return out
+}
+
+// validate ensures every overlay key refers to at least one field described
+// in files.
+func (o *overlay) validate(files *protoregistry.Files) error {
+	if o == nil {
+		return nil
+	}
+	var errs []error
+	for name := range o.exact {
+		desc, err := files.FindDescriptorByName(name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unknown overlay field %q", name))
+		} else if _, ok := desc.(protoreflect.FieldDescriptor); !ok {
+			errs = append(errs, fmt.Errorf("overlay key %q is not a field", name))
+		}
+	}
+	unmatched := make(map[string]struct{}, len(o.patterns))
+	for _, pattern := range o.patterns {
+		unmatched[pattern] = struct{}{}
+	}
+	if len(unmatched) > 0 {
+		files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+			rangeFields(file, func(field protoreflect.FieldDescriptor) {
+				for pattern := range unmatched {
+					if ok, _ := path.Match(pattern, string(field.FullName())); ok {
+						delete(unmatched, pattern)
+					}
+				}
+			})
+			return len(unmatched) > 0
+		})
+	}
+	for _, pattern := range o.patterns {
+		if _, ok := unmatched[pattern]; ok {
+			errs = append(errs, fmt.Errorf("overlay pattern %q matches no fields", pattern))
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errors.Join(errs...)
+}
+
+type fieldContainer interface {
+	Messages() protoreflect.MessageDescriptors
+	Extensions() protoreflect.ExtensionDescriptors
+}
+
+// rangeFields calls fn for every field and extension declared within
+// container, including those of nested messages.
+func rangeFields(container fieldContainer, fn func(field protoreflect.FieldDescriptor)) {
+	exts := container.Extensions()
+	for i, n := 0, exts.Len(); i < n; i++ {
+		fn(exts.Get(i))
+	}
+	msgs := container.Messages()
+	for i, n := 0, msgs.Len(); i < n; i++ {
+		msg := msgs.Get(i)
+		fields := msg.Fields()
+		for j, m := 0, fields.Len(); j < m; j++ {
+			fn(fields.Get(j))
+		}
+		rangeFields(msg, fn)
+	}
+}
+
+func isGlob(key string) bool {
+	return strings.ContainsAny(key, `*?[\`)
+}
+
+// parseOverlay parses data as YAML (a superset of JSON), falling back to the
+// protobuf text format.
+func parseOverlay(data []byte) (*pb.Overlay, error) {
+	overlay, yamlErr := parseOverlayYAML(data)
+	if yamlErr == nil {
+		return overlay, nil
+	}
+	overlay, textErr := parseOverlayText(data)
+	if textErr == nil {
+		return overlay, nil
+	}
+	return nil, fmt.Errorf("overlay is neither valid YAML/JSON (%w) nor text format (%w)", yamlErr, textErr)
+}
+
+func parseOverlayYAML(data []byte) (*pb.Overlay, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return &pb.Overlay{}, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	return parseOverlayJSON(data)
+}
+
+func parseOverlayJSON(data []byte) (*pb.Overlay, error) {
+	overlay := &pb.Overlay{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return overlay, nil
+	}
+	return overlay, protojson.Unmarshal(data, overlay)
+}
+
+func parseOverlayText(data []byte) (*pb.Overlay, error) {
+	overlay := &pb.Overlay{}
+	return overlay, prototext.Unmarshal(data, overlay)
+}
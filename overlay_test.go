@@ -0,0 +1,166 @@
+package protogofakeit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOverlay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("formats", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			overlay string
+		}{
+			{
+				name: "yaml",
+				overlay: `
+fields:
+  gofakeit.test.ScalarDefaults.string:
+    tag: foobar
+  gofakeit.test.ScalarDefaults.int32:
+    tag: "123"
+`,
+			},
+			{
+				name: "json",
+				overlay: `{"fields": {
+					"gofakeit.test.ScalarDefaults.string": {"tag": "foobar"},
+					"gofakeit.test.ScalarDefaults.int32": {"tag": "123"}
+				}}`,
+			},
+			{
+				name: "text",
+				overlay: `
+fields { key: "gofakeit.test.ScalarDefaults.string" value { tag: "foobar" } }
+fields { key: "gofakeit.test.ScalarDefaults.int32" value { tag: "123" } }
+`,
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+				msg := &test.ScalarDefaults{}
+				err := initProtoFaker(t, WithOverlay(strings.NewReader(tc.overlay))).FakeProto(msg)
+				require.NoError(t, err)
+				assert.Equal(t, "foobar", msg.GetString_())
+				assert.Equal(t, int32(123), msg.GetInt32())
+			})
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		t.Parallel()
+		overlay := `
+fields:
+  gofakeit.test.ScalarStaticTags.string:
+    tag: overlaid
+  gofakeit.test.RepeatedTags.foo:
+    repeated:
+      len: 2
+`
+		scalars := &test.ScalarStaticTags{}
+		repeated := &test.RepeatedTags{}
+		pf := initProtoFaker(t, WithOverlay(strings.NewReader(overlay)))
+		require.NoError(t, pf.FakeProto(scalars))
+		require.NoError(t, pf.FakeProto(repeated))
+		assert.Equal(t, "overlaid", scalars.GetString_())
+		assert.Equal(t, int32(123), scalars.GetInt32())
+		assert.Equal(t, []string{"bar", "bar"}, repeated.GetFoo())
+	})
+
+	t.Run("globs", func(t *testing.T) {
+		t.Parallel()
+		overlay := `
+fields:
+  "gofakeit.test.Scalar*.string":
+    tag: glob
+  "gofakeit.test.*Defaults.*":
+    skip: true
+  gofakeit.test.ScalarDefaults.bytes:
+    tag: exact
+`
+		pf := initProtoFaker(t, WithOverlay(strings.NewReader(overlay)))
+
+		defaults := &test.ScalarDefaults{}
+		require.NoError(t, pf.FakeProto(defaults))
+		assert.Equal(t, "glob", defaults.GetString_(), "longer pattern should win")
+		assert.Equal(t, []byte("exact"), defaults.GetBytes(), "exact match should win")
+		assert.Zero(t, defaults.GetInt64())
+
+		tags := &test.ScalarStaticTags{}
+		require.NoError(t, pf.FakeProto(tags))
+		assert.Equal(t, "glob", tags.GetString_())
+	})
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "overlay.txtpb")
+		err := os.WriteFile(path, []byte(`fields { key: "gofakeit.test.ScalarDefaults.string" value { tag: "foobar" } }`), 0o600)
+		require.NoError(t, err)
+
+		msg := &test.ScalarDefaults{}
+		err = initProtoFaker(t, WithOverlayFile(path)).FakeProto(msg)
+		require.NoError(t, err)
+		assert.Equal(t, "foobar", msg.GetString_())
+
+		err = initProtoFaker(t, WithOverlayFile(path+".missing")).FakeProto(msg)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			overlay string
+			err     string
+		}{
+			{
+				name:    "syntax",
+				overlay: `{"fields": [}`,
+				err:     "neither valid",
+			},
+			{
+				name:    "unknown_field",
+				overlay: `fields: {gofakeit.test.ScalarDefaults.nope: {skip: true}}`,
+				err:     `unknown overlay field "gofakeit.test.ScalarDefaults.nope"`,
+			},
+			{
+				name:    "not_field",
+				overlay: `fields: {gofakeit.test.ScalarDefaults: {skip: true}}`,
+				err:     "is not a field",
+			},
+			{
+				name:    "unmatched_glob",
+				overlay: `fields: {"gofakeit.nope.*": {skip: true}}`,
+				err:     "matches no fields",
+			},
+			{
+				name:    "bad_glob",
+				overlay: `fields: {"gofakeit.[": {skip: true}}`,
+				err:     "invalid overlay pattern",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+				err := initProtoFaker(t, WithOverlay(strings.NewReader(tc.overlay))).
+					FakeProto(&test.ScalarDefaults{})
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+			})
+		}
+	})
+}
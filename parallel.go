@@ -0,0 +1,130 @@
+package protogofakeit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"google.golang.org/protobuf/proto"
+)
+
+// GenerateParallel produces n messages of type T, populated with fake data via
+// pf, across the given number of worker goroutines. Each message is generated
+// with its own faker, seeded deterministically from pf's faker and the index
+// of the message, so the output is identical regardless of the number of
+// workers. The faker provided to [New] does not need to be configured for
+// concurrent use, though any custom template functions or message generators
+// must be safe for concurrent use.
+//
+// Messages are passed to sink in index order from the calling goroutine, so
+// sink may write them out sequentially without further synchronization:
+//
+//	err := protogofakeit.GenerateParallel(ctx, pf, runtime.NumCPU(), 1e6,
+//		func(i int, user *pb.User) error {
+//			return enc.Encode(user)
+//		})
+//
+// Generation stops at the first error from populating a message or from sink,
+// or once ctx is done, returning the error. pf must be created by [New], or
+// an error is returned without generating any messages.
+func GenerateParallel[T proto.Message](
+	ctx context.Context,
+	pf ProtoFaker,
+	workers, n int,
+	sink func(i int, msg T) error,
+) error {
+	base, err := implementation(pf)
+	if err != nil {
+		return err
+	}
+	if base.err != nil {
+		return base.err
+	}
+	seed := base.faker.Uint64()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// pending preserves the order of results, bounding the number of messages
+	// generated ahead of sink.
+	jobs := make(chan parallelJob[T])
+	pending := make(chan chan parallelResult[T], max(workers, 1))
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+		for i := range n {
+			res := make(chan parallelResult[T], 1)
+			select {
+			case pending <- res:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- parallelJob[T]{index: i, res: res}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Go(func() {
+			for job := range jobs {
+				msg := newMessage[T]()
+				err := base.fork(parallelSeed(seed, job.index)).FakeProto(msg)
+				job.res <- parallelResult[T]{index: job.index, msg: msg, err: err}
+			}
+		})
+	}
+
+	err = drainParallel(ctx, pending, sink)
+	cancel()
+	wg.Wait()
+	return err
+}
+
+type parallelJob[T proto.Message] struct {
+	index int
+	res   chan<- parallelResult[T]
+}
+
+type parallelResult[T proto.Message] struct {
+	index int
+	msg   T
+	err   error
+}
+
+func drainParallel[T proto.Message](
+	ctx context.Context,
+	pending <-chan chan parallelResult[T],
+	sink func(i int, msg T) error,
+) error {
+	for res := range pending {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case out := <-res:
+			if out.err != nil {
+				return out.err
+			}
+			if err := sink(out.index, out.msg); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// fork returns a copy of pf that draws from its own faker, seeded by seed.
+func (pf *protoFaker) fork(seed uint64) *protoFaker {
+	forked := *pf
+	forked.faker = gofakeit.NewCustom(&splitMix64{state: seed})
+	return &forked
+}
+
+// parallelSeed derives the seed of the i-th message generated in parallel from
+// the seed of the whole batch.
+func parallelSeed(seed uint64, i int) uint64 {
+	return mix64(seed + uint64(i+1)*splitMix64Gamma) //nolint:gosec // i is non-negative
+}
@@ -0,0 +1,105 @@
+package protogofakeit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGenerateParallel(t *testing.T) {
+	t.Parallel()
+
+	generate := func(t *testing.T, workers int) []*test.ScalarDefaults {
+		t.Helper()
+		var msgs []*test.ScalarDefaults
+		err := GenerateParallel(t.Context(), initProtoFaker(t, withSeed(42)), workers, 50,
+			func(i int, msg *test.ScalarDefaults) error {
+				assert.Equal(t, len(msgs), i)
+				msgs = append(msgs, msg)
+				return nil
+			})
+		require.NoError(t, err)
+		require.Len(t, msgs, 50)
+		return msgs
+	}
+
+	t.Run("reproducible", func(t *testing.T) {
+		t.Parallel()
+		serial := generate(t, 1)
+		assert.False(t, proto.Equal(serial[0], serial[1]))
+		for _, workers := range []int{0, 4, 16} {
+			parallel := generate(t, workers)
+			for i := range serial {
+				assert.True(t, proto.Equal(serial[i], parallel[i]), "workers=%d index=%d", workers, i)
+			}
+		}
+	})
+
+	t.Run("stable_seeding", func(t *testing.T) {
+		t.Parallel()
+		var a, b []*test.MapDefaults
+		for _, out := range []*[]*test.MapDefaults{&a, &b} {
+			pf := initProtoFaker(t, WithStableSeeding(), WithMapSize(1, 2), withSeed(42))
+			err := GenerateParallel(t.Context(), pf, 4, 10,
+				func(_ int, msg *test.MapDefaults) error {
+					*out = append(*out, msg)
+					return nil
+				})
+			require.NoError(t, err)
+		}
+		for i := range a {
+			assert.True(t, proto.Equal(a[i], b[i]))
+		}
+	})
+
+	t.Run("sink_error", func(t *testing.T) {
+		t.Parallel()
+		errSink := errors.New("sink")
+		count := 0
+		err := GenerateParallel(t.Context(), initProtoFaker(t), 4, 100,
+			func(i int, _ *test.ScalarDefaults) error {
+				if count++; i == 10 {
+					return errSink
+				}
+				return nil
+			})
+		require.ErrorIs(t, err, errSink)
+		assert.Equal(t, 11, count)
+	})
+
+	t.Run("fake_error", func(t *testing.T) {
+		t.Parallel()
+		err := GenerateParallel(t.Context(), initProtoFaker(t), 4, 100,
+			func(int, *test.CustomTemplate) error {
+				t.Fatal("unexpected call to sink")
+				return nil
+			})
+		require.Error(t, err)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		err := GenerateParallel(ctx, initProtoFaker(t), 4, 1_000_000,
+			func(i int, _ *test.ScalarDefaults) error {
+				if i == 5 {
+					cancel()
+				}
+				return nil
+			})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		err := GenerateParallel(t.Context(), ProtoFaker(nil), 4, 1,
+			func(int, *test.ScalarDefaults) error { return nil })
+		require.ErrorContains(t, err, "unsupported")
+	})
+}
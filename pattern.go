@@ -0,0 +1,221 @@
+package protogofakeit
+
+import (
+	"errors"
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brianvoe/gofakeit/v6"
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxPatternRepeat is the number of repetitions beyond the minimum generated
+// for unbounded repetition (such as '*', '+', or '{2,}') in a pattern.
+const maxPatternRepeat = 10
+
+// compiledPattern generates strings matching an RE2 regular expression.
+type compiledPattern struct {
+	re *syntax.Regexp
+}
+
+func compilePattern(pattern string) (*compiledPattern, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkPattern(re); err != nil {
+		return nil, err
+	}
+	return &compiledPattern{re: re}, nil
+}
+
+// checkPattern reports an error for constructs that cannot be generated.
+func checkPattern(re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return errors.New("pattern never matches")
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return errors.New("pattern never matches")
+		}
+	case syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return fmt.Errorf("unsupported word boundary %q", re.String())
+	default:
+	}
+	for _, sub := range re.Sub {
+		if err := checkPattern(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *compiledPattern) generate(faker *gofakeit.Faker) string {
+	var out strings.Builder
+	generatePattern(&out, faker, p.re)
+	return out.String()
+}
+
+//nolint:cyclop
+func generatePattern(out *strings.Builder, faker *gofakeit.Faker, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && faker.Bool() {
+				r = unicode.SimpleFold(r)
+			}
+			out.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		out.WriteRune(classRune(faker, re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		out.WriteByte(byte(faker.Rand.Intn('~'-' '+1) + ' '))
+	case syntax.OpCapture:
+		generatePattern(out, faker, re.Sub[0])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		minimum, maximum := repeatBounds(re)
+		for range faker.Number(minimum, maximum) {
+			generatePattern(out, faker, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			generatePattern(out, faker, sub)
+		}
+	case syntax.OpAlternate:
+		generatePattern(out, faker, re.Sub[faker.Rand.Intn(len(re.Sub))])
+	case syntax.OpEmptyMatch,
+		syntax.OpBeginLine, syntax.OpEndLine,
+		syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpNoMatch, syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		fallthrough
+	default:
+	}
+}
+
+// repeatBounds returns the number of repetitions of the repeat operator re,
+// capping unbounded repetition.
+func repeatBounds(re *syntax.Regexp) (minimum, maximum int) {
+	switch re.Op {
+	case syntax.OpStar:
+		return 0, maxPatternRepeat
+	case syntax.OpPlus:
+		return 1, 1 + maxPatternRepeat
+	case syntax.OpQuest:
+		return 0, 1
+	default:
+		if re.Max < 0 {
+			return re.Min, re.Min + maxPatternRepeat
+		}
+		return re.Min, re.Max
+	}
+}
+
+// classRune draws a rune from the character class ranges, a flattened list
+// of inclusive lo-hi pairs. Graphic runes are preferred, as negated classes
+// span mostly unassigned code points.
+func classRune(faker *gofakeit.Faker, ranges []rune) rune {
+	total := 0
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	draw := func() rune {
+		n := faker.Rand.Intn(total)
+		for i := 0; i < len(ranges); i += 2 {
+			if width := int(ranges[i+1]-ranges[i]) + 1; n >= width {
+				n -= width
+				continue
+			}
+			return ranges[i] + rune(n)
+		}
+		return unicode.ReplacementChar
+	}
+
+	fallback := unicode.ReplacementChar
+	for range maxCharAttempts {
+		r := draw()
+		if !utf8.ValidRune(r) {
+			continue
+		}
+		if unicode.IsGraphic(r) {
+			return r
+		}
+		fallback = r
+	}
+	return fallback
+}
+
+// WithValidatePatterns generates string and bytes fields without a generator
+// from the pattern of their buf.validate string or bytes rules, if any. Fields
+// with patterns that cannot be generated (see the pattern generator) are
+// generated as if they had no pattern rule.
+func WithValidatePatterns() Option {
+	return optionFunc(func(pf *protoFaker) { pf.validatePattern = true })
+}
+
+// Field numbers of the buf.validate rules holding patterns, within the
+// buf.validate.field option (see validateNumber).
+const (
+	validateStringNumber        protowire.Number = 14 // FieldRules.string
+	validateBytesNumber         protowire.Number = 15 // FieldRules.bytes
+	validateRepeatedNumber      protowire.Number = 18 // FieldRules.repeated
+	validateRepeatedItemsNumber protowire.Number = 4  // RepeatedRules.items
+	validateStringPatternNumber protowire.Number = 6  // StringRules.pattern
+	validateBytesPatternNumber  protowire.Number = 4  // BytesRules.pattern
+)
+
+// validatePattern returns a Generator for the pattern of the buf.validate
+// string or bytes rules of desc (or of its elements, if repeated), or nil if
+// there is none or it cannot be generated.
+func validatePattern(desc protoreflect.FieldDescriptor) *pb.Generator {
+	if desc.IsMap() {
+		return nil
+	}
+	path := []protowire.Number{validateNumber}
+	if desc.IsList() {
+		path = append(path, validateRepeatedNumber, validateRepeatedItemsNumber)
+	}
+	switch desc.Kind() {
+	case protoreflect.StringKind:
+		path = append(path, validateStringNumber, validateStringPatternNumber)
+	case protoreflect.BytesKind:
+		path = append(path, validateBytesNumber, validateBytesPatternNumber)
+	default:
+		return nil
+	}
+
+	pattern, ok := optionBytes(marshalOptions(desc.Options()), path...)
+	if !ok {
+		return nil
+	}
+	if _, err := compilePattern(string(pattern)); err != nil {
+		return nil
+	}
+	gen := &pb.Generator{Apply: &pb.Generator_Pattern{Pattern: string(pattern)}}
+	if desc.IsList() {
+		return &pb.Generator{Apply: &pb.Generator_Repeated{Repeated: &pb.Repeated{Element: gen}}}
+	}
+	return gen
+}
+
+// optionBytes returns the last value of the length-delimited field at path
+// within the encoded message msg, with each preceding number naming a nested
+// message field.
+func optionBytes(msg []byte, path ...protowire.Number) (out []byte, found bool) {
+	rangeFieldValues(msg, path[0], func(typ protowire.Type, val []byte) {
+		if typ != protowire.BytesType {
+			return
+		}
+		val, _ = protowire.ConsumeBytes(val)
+		if len(path) == 1 {
+			out, found = val, true
+		} else if nested, ok := optionBytes(val, path[1:]...); ok {
+			out, found = nested, true
+		}
+	})
+	return out, found
+}
@@ -0,0 +1,68 @@
+package protogofakeit
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPattern(t *testing.T) {
+	t.Parallel()
+
+	pf := initProtoFaker(t, WithValidatePatterns())
+	for range 50 {
+		msg := &test.Pattern{}
+		require.NoError(t, pf.FakeProto(msg))
+		assert.Regexp(t, `^[A-Z]{3}-\d{4}$`, msg.GetSku())
+		assert.Regexp(t, `^\p{Greek}{2,4}$`, msg.GetGreek())
+		assert.Regexp(t, `^[0-9a-f]{8}$`, string(msg.GetHex()))
+		assert.Regexp(t, `^(?i:foo|bar)$`, msg.GetChoice())
+		assert.Regexp(t, `^a+b*[^x]?$`, msg.GetUnbounded())
+		for _, code := range msg.GetCodes() {
+			assert.Regexp(t, `^[A-Z]{2}$`, code)
+		}
+		assert.Regexp(t, `^v[0-9]$`, msg.GetValidated())
+		for _, val := range msg.GetValidatedList() {
+			assert.Regexp(t, `^w[0-9]$`, val)
+		}
+		assert.Regexp(t, `^[a-zA-Z]+$`, msg.GetValidatedBoundary(), "unsupported patterns are ignored")
+	}
+
+	msg := &test.Pattern{}
+	require.NoError(t, initProtoFaker(t).FakeProto(msg))
+	assert.Regexp(t, `^[a-zA-Z]+$`, msg.GetValidated(), "patterns are opt-in")
+}
+
+func TestCompilePattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{
+		`^\d{3}(-\d{2})?$`,
+		`[[:alpha:]]{1,5}\s[\p{Han}]`,
+		`(?s).{0,3}`,
+		`[^\x00-\x{10FFFE}]`,
+		`x{2,}y{,3}`,
+		`^$`,
+	} {
+		compiled, err := compilePattern(pattern)
+		require.NoError(t, err, pattern)
+		re := regexp.MustCompile(`^(?:` + pattern + `)$`)
+		for seed := range 10 {
+			faker := gofakeit.New(int64(seed + 1))
+			assert.Regexp(t, re, compiled.generate(faker), pattern)
+		}
+	}
+
+	for pattern, msg := range map[string]string{
+		`(`:                  "missing closing )",
+		`a\Bb`:               "unsupported word boundary",
+		`[^\x00-\x{10FFFF}]`: "never matches",
+	} {
+		_, err := compilePattern(pattern)
+		require.ErrorContains(t, err, msg, pattern)
+	}
+}
@@ -0,0 +1,208 @@
+package protogofakeit
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Compile resolves and caches within pf the generation plan of the message
+// type described by desc and of every message type reachable from its fields.
+// Plans are otherwise compiled on first use; Compile permits warming the cache
+// ahead of bulk generation. An error is returned if the configuration of any
+// of the messages is invalid or if pf was not created by [New].
+func Compile(pf ProtoFaker, desc protoreflect.MessageDescriptor) error {
+	base, err := implementation(pf)
+	if err != nil {
+		return err
+	}
+	return base.compile(desc)
+}
+
+func (pf *protoFaker) compile(desc protoreflect.MessageDescriptor) error {
+	if pf.err != nil {
+		return pf.err
+	}
+	return pf.compileAll(desc, map[protoreflect.FullName]bool{})
+}
+
+func (pf *protoFaker) compileAll(desc protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) error {
+	if seen[desc.FullName()] {
+		return nil
+	}
+	seen[desc.FullName()] = true
+	err := pf.plan(desc).err
+	fields := desc.Fields()
+	for i, n := 0, fields.Len(); i < n; i++ {
+		if msg := fields.Get(i).Message(); msg != nil {
+			err = errors.Join(err, pf.compileAll(msg, seen))
+		}
+	}
+	return err
+}
+
+// planCache holds the compiled plans of a ProtoFaker, safe for concurrent use.
+// It is shared by any copies of the ProtoFaker (see GenerateParallel).
+type planCache struct {
+	messages sync.Map // protoreflect.MessageDescriptor → *messagePlan
+}
+
+// messagePlan is the generation plan of a message type, with the generators
+// of its fields resolved from annotations, overlays, rules, and smart
+// defaults ahead of time.
+type messagePlan struct {
+	oneofs []*oneofPlan
+	// fields excludes those contained within a oneof.
+	fields []*fieldPlan
+	// byName includes all fields, including those within a oneof.
+	byName map[protoreflect.Name]*fieldPlan
+	// ensure are the predicates generated messages must satisfy.
+	ensure []*celProgram
+	// refs are the compiled references of the fields, by path.
+	refs map[string]*reference
+	// err holds any errors compiling the generators of the fields, such as
+	// template parse errors.
+	err error
+}
+
+type oneofPlan struct {
+	desc     protoreflect.OneofDescriptor
+	fields   []*fieldPlan
+	required bool
+}
+
+type fieldPlan struct {
+	desc     protoreflect.FieldDescriptor
+	gen      *generatorPlan
+	required bool
+}
+
+// generatorPlan is a resolved Generator with its tag or template compiled,
+// along with the plans of the generators used for list elements and map keys
+// and values.
+type generatorPlan struct {
+	*pb.Generator
+
+	tag     *compiledTag
+	tpl     *compiledTemplate
+	strings *stringPlan
+	pattern *compiledPattern
+	bytes   *bytesPlan
+	cel     *celProgram
+	element *generatorPlan
+	key     *generatorPlan
+	value   *generatorPlan
+}
+
+// plan returns the cached generation plan for desc, compiling it if
+// necessary.
+func (pf *protoFaker) plan(desc protoreflect.MessageDescriptor) *messagePlan {
+	if cached, ok := pf.plans.messages.Load(desc); ok {
+		plan, _ := cached.(*messagePlan)
+		return plan
+	}
+	cached, _ := pf.plans.messages.LoadOrStore(desc, pf.compileMessage(desc))
+	plan, _ := cached.(*messagePlan)
+	return plan
+}
+
+func (pf *protoFaker) compileMessage(desc protoreflect.MessageDescriptor) *messagePlan {
+	plan := &messagePlan{
+		byName: map[protoreflect.Name]*fieldPlan{},
+		refs:   map[string]*reference{},
+	}
+	env := sync.OnceValues(func() (*cel.Env, error) { return newCELEnv(desc) })
+	oneofs := desc.Oneofs()
+	for i, n := 0, oneofs.Len(); i < n; i++ {
+		oneof := oneofs.Get(i)
+		oneofPlan := &oneofPlan{desc: oneof, required: isRequiredOneof(oneof)}
+		fields := oneof.Fields()
+		for j, m := 0, fields.Len(); j < m; j++ {
+			field, err := pf.compileField(env, fields.Get(j))
+			oneofPlan.fields = append(oneofPlan.fields, field)
+			plan.byName[field.desc.Name()] = field
+			plan.err = errors.Join(plan.err, err)
+		}
+		plan.oneofs = append(plan.oneofs, oneofPlan)
+	}
+	fields := desc.Fields()
+	for i, n := 0, fields.Len(); i < n; i++ {
+		if fdesc := fields.Get(i); fdesc.ContainingOneof() == nil {
+			field, err := pf.compileField(env, fdesc)
+			plan.fields = append(plan.fields, field)
+			plan.byName[field.desc.Name()] = field
+			plan.err = errors.Join(plan.err, err)
+		}
+	}
+	var err error
+	plan.ensure, err = compileEnsure(env, desc)
+	plan.err = errors.Join(plan.err, err)
+	fieldsByRef, issues := orderFields(desc, plan)
+	plan.fields = fieldsByRef
+	for _, issue := range issues {
+		plan.err = errors.Join(plan.err, errors.New(issue.String()))
+	}
+	return plan
+}
+
+func (pf *protoFaker) compileField(
+	env func() (*cel.Env, error),
+	desc protoreflect.FieldDescriptor,
+) (*fieldPlan, error) {
+	gen, err := pf.compileGenerator(pf.generator(desc), pf.stringsFor(desc))
+	err = errors.Join(err, compileCELField(env, desc, gen))
+	if err != nil {
+		err = fmt.Errorf("failed to compile generator for %s: %w", desc.FullName(), err)
+	}
+	return &fieldPlan{desc: desc, gen: gen, required: isRequired(desc)}, err
+}
+
+// compileGenerator compiles gen, which may be nil. List elements and map
+// values without their own generator reuse the plan of gen itself. Strings are
+// generated per strs, unless gen has its own strings options.
+func (pf *protoFaker) compileGenerator(gen *pb.Generator, strs *pb.Strings) (plan *generatorPlan, err error) {
+	plan = &generatorPlan{Generator: gen}
+	if tag := gen.GetTag(); tag != "" {
+		plan.tag = compileTag(tag)
+	}
+	if tpl := gen.GetTemplate(); tpl != "" {
+		plan.tpl, err = compileTemplate(tpl, pf.tplOptions)
+	}
+	if pattern := gen.GetPattern(); pattern != "" {
+		if plan.pattern, err = compilePattern(pattern); err != nil {
+			err = fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if genStrs := gen.GetStrings(); genStrs != nil {
+		strs = genStrs
+	}
+
+	var nestedErr error
+	if b := gen.GetBytes(); b != nil {
+		plan.bytes, nestedErr = pf.compileBytes(b)
+		err = errors.Join(err, nestedErr)
+	}
+	if strs != nil {
+		plan.strings, nestedErr = compileStrings(strs, pf.stringSize)
+		err = errors.Join(err, nestedErr)
+	}
+
+	plan.element, plan.value, plan.key = plan, plan, &generatorPlan{strings: plan.strings}
+	if el := gen.GetRepeated().GetElement(); el != nil {
+		plan.element, nestedErr = pf.compileGenerator(el, strs)
+		err = errors.Join(err, nestedErr)
+	}
+	if val := gen.GetMap().GetValue(); val != nil {
+		plan.value, nestedErr = pf.compileGenerator(val, strs)
+		err = errors.Join(err, nestedErr)
+	}
+	if key := gen.GetMap().GetKey(); key != nil {
+		plan.key, nestedErr = pf.compileGenerator(key, strs)
+		err = errors.Join(err, nestedErr)
+	}
+	return plan, err
+}
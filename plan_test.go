@@ -0,0 +1,55 @@
+package protogofakeit
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reachable", func(t *testing.T) {
+		t.Parallel()
+		pf, ok := initProtoFaker(t).(*protoFaker)
+		require.True(t, ok)
+		desc := (&test.CustomContainer{}).ProtoReflect().Descriptor()
+		require.NoError(t, Compile(pf, desc))
+
+		for _, msg := range []protoreflect.MessageDescriptor{
+			desc,
+			desc.Fields().ByName("singular").Message(),
+		} {
+			_, ok = pf.plans.messages.Load(msg)
+			assert.True(t, ok, msg.FullName())
+		}
+
+		plan := pf.plan(desc)
+		assert.Same(t, plan, pf.plan(desc))
+		require.NoError(t, pf.FakeProto(&test.CustomContainer{}))
+		assert.Same(t, plan, pf.plan(desc))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithOverlayFile("testdata/does-not-exist.yaml"))
+		require.Error(t, Compile(pf, (&test.ScalarDefaults{}).ProtoReflect().Descriptor()))
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		t.Parallel()
+		pf := New(gofakeit.New(0))
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Go(func() {
+				assert.NoError(t, pf.FakeProto(&test.MapTags{}))
+			})
+		}
+		wg.Wait()
+	})
+}
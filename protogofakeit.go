@@ -3,6 +3,7 @@
 package protogofakeit
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,11 +24,22 @@ const (
 	defaultMinSize  = 4
 	defaultMaxSize  = 10
 
+	anyTypeURLPrefix      = "type.googleapis.com/"
+	anyTypeURLFieldNumber = 1
+	anyValueFieldNumber   = 2
+
 	wktTimestampFQN = "google.protobuf.Timestamp"
 	wktDurationFQN  = "google.protobuf.Duration"
+	wktAnyFQN       = "google.protobuf.Any"
 )
 
 // ProtoFaker populates a protobuf message with fake data.
+//
+// Any implementation may be used with [FakeDescriptor], but the other package
+// functions accepting a ProtoFaker ([FakeByName], [Compile], [FakeFields],
+// [FakeFieldsExcept], [Mutate], and [GenerateParallel]) rely on the internals
+// of values created by [New]. They return an error for any other
+// implementation, including those wrapping or mocking a value from New.
 type ProtoFaker interface {
 	// FakeProto populates msg with fake data, optionally configured through
 	// annotations on the protobuf message. An error is returned if the
@@ -35,7 +49,8 @@ type ProtoFaker interface {
 
 // New creates a [ProtoFaker] from the given gofakeit.Faker and [Option] values.
 // If the returned value is intended to be used in a concurrent context, the
-// provided faker should also be configured for concurrent use.
+// provided faker should also be configured for concurrent use. To generate
+// many messages concurrently and reproducibly, see [GenerateParallel].
 func New(faker *gofakeit.Faker, options ...Option) ProtoFaker {
 	defaultSize := size{min: defaultMinSize, max: defaultMaxSize}
 	pfaker := &protoFaker{
@@ -47,10 +62,14 @@ func New(faker *gofakeit.Faker, options ...Option) ProtoFaker {
 		listSize:        defaultSize,
 		mapSize:         defaultSize,
 		timestampFormat: time.RFC3339Nano,
+		files:           protoregistry.GlobalFiles,
+		types:           protoregistry.GlobalTypes,
+		plans:           &planCache{},
 	}
 	for _, opt := range options {
 		opt.apply(pfaker)
 	}
+	pfaker.addErr(pfaker.overlay.validate(pfaker.files))
 	return pfaker
 }
 
@@ -117,118 +136,381 @@ func WithTimestampFormat(format string) Option {
 	})
 }
 
+// WithFiles sets the descriptors used to validate overlays (see
+// [WithOverlay]) and to resolve the message types of google.protobuf.Any
+// fields. The default is [protoregistry.GlobalFiles], resolving types from
+// [protoregistry.GlobalTypes].
+func WithFiles(files *protoregistry.Files) Option {
+	return optionFunc(func(pf *protoFaker) {
+		pf.files = files
+		pf.types = dynamicpb.NewTypes(files)
+	})
+}
+
 type protoFaker struct {
 	faker           *gofakeit.Faker
 	tplOptions      *gofakeit.TemplateOptions
+	errorMode       ErrorMode
+	fillMode        FillMode
 	maxDepth        int
 	stringSize      size
 	bytesSize       size
 	listSize        size
 	mapSize         size
 	timestampFormat string
+	stableSeeding   bool
+	minimal         bool
+	validatePattern bool
+	edgeCaseRate    float64
+	strings         *pb.Strings
+	msgGens         map[protoreflect.FullName]MessageGenerator
+	overlay         *overlay
+	smartDefaults   []smartDefault
+	rules           []rule
+	numRules        int // including invalid rules, which are not in rules
+	files           *protoregistry.Files
+	types           protoregistry.MessageTypeResolver
+	plans           *planCache
+	err             error
 }
 
 // FakeProto populates msg with fake data, optionally configured through
 // annotations on the protobuf message. An error is returned if the
 // configuration on msg is invalid (typically a parse error).
 func (pf *protoFaker) FakeProto(msg proto.Message) error {
-	return pf.fake(0, msg.ProtoReflect())
+	if pf.err != nil {
+		return pf.err
+	}
+	return pf.fakeMessage(pf.newScope(pf.types), msg.ProtoReflect())
+}
+
+// FakeDescriptor creates a dynamic message of the type described by desc and
+// populates it with fake data via pf, the same as FakeProto. This permits
+// generating fake data without the compiled Go types of a message.
+func FakeDescriptor(pf ProtoFaker, desc protoreflect.MessageDescriptor) (*dynamicpb.Message, error) {
+	msg := dynamicpb.NewMessage(desc)
+	if err := pf.FakeProto(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// FakeByName creates a dynamic message of the named type, described in
+// files, and populates it with fake data via pf, the same as FakeDescriptor.
+// The types of any google.protobuf.Any fields are also resolved from files. If
+// files is nil, the descriptors configured via [WithFiles] are used. pf must
+// be created by [New], or an error is returned.
+func FakeByName(pf ProtoFaker, name protoreflect.FullName, files *protoregistry.Files) (*dynamicpb.Message, error) {
+	base, err := implementation(pf)
+	if err != nil {
+		return nil, err
+	}
+	return base.fakeByName(name, files)
+}
+
+func (pf *protoFaker) fakeByName(name protoreflect.FullName, files *protoregistry.Files) (*dynamicpb.Message, error) {
+	types := pf.types
+	if files == nil {
+		files = pf.files
+	} else {
+		types = dynamicpb.NewTypes(files)
+	}
+	desc, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve message %q: %w", name, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a message", name)
+	}
+	return pf.fakeDynamic(msgDesc, types)
+}
+
+// implementation returns pf as implemented by this package, or an error if
+// it is any other implementation.
+func implementation(pf ProtoFaker) (*protoFaker, error) {
+	base, ok := pf.(*protoFaker)
+	if !ok {
+		return nil, fmt.Errorf("unsupported ProtoFaker implementation: %T", pf)
+	}
+	return base, nil
+}
+
+func (pf *protoFaker) fakeDynamic(
+	desc protoreflect.MessageDescriptor,
+	types protoregistry.MessageTypeResolver,
+) (*dynamicpb.Message, error) {
+	if pf.err != nil {
+		return nil, pf.err
+	}
+	msg := dynamicpb.NewMessage(desc)
+	if err := pf.fakeMessage(pf.newScope(types), msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// scope describes the position of a value being generated within a single
+// call to the ProtoFaker.
+type scope struct {
+	depth int
+	types protoregistry.MessageTypeResolver
+	faker *gofakeit.Faker
+	// stable and seed are only set if stable seeding is enabled, in which case
+	// faker is derived from seed. See WithStableSeeding.
+	stable bool
+	seed   uint64
+	// msg is the message whose fields are being generated, referenced by
+	// templates and copy_from.
+	msg protoreflect.Message
+}
+
+func (pf *protoFaker) newScope(types protoregistry.MessageTypeResolver) scope {
+	sc := scope{types: types, faker: pf.faker}
+	if pf.stableSeeding {
+		sc.stable = true
+		sc.seed = pf.faker.Uint64()
+	}
+	return sc
+}
+
+// nested returns the scope of a message nested within the current one.
+func (sc scope) nested() scope {
+	sc.depth++
+	return sc
+}
+
+func (pf *protoFaker) fakeMessage(sc scope, msg protoreflect.Message) error {
+	if gen, ok := pf.msgGens[msg.Descriptor().FullName()]; ok {
+		return gen(MessageContext{pf: pf, sc: sc}, msg)
+	}
+	return pf.fake(sc, msg)
 }
 
-func (pf *protoFaker) fake(depth int, msg protoreflect.Message) error {
-	desc := msg.Descriptor()
-	if err := pf.fakeOneofs(depth, msg, desc); err != nil {
-		return err
+func (pf *protoFaker) fake(sc scope, msg protoreflect.Message) error {
+	plan := pf.plan(msg.Descriptor())
+	if plan.err != nil {
+		return plan.err
 	}
-	return pf.fakeFields(depth, msg, desc)
+	if len(plan.ensure) > 0 {
+		return pf.fakeEnsured(sc, msg, plan)
+	}
+	return pf.fakeOnce(sc, msg, plan)
+}
+
+// fakeOnce generates the fields of msg per plan, without regard for its ensure
+// predicates.
+func (pf *protoFaker) fakeOnce(sc scope, msg protoreflect.Message, plan *messagePlan) error {
+	if pf.minimal {
+		return pf.fakeMinimal(sc, msg, plan)
+	}
+	var errs fieldErrors
+	if pf.collect(&errs, pf.fakeOneofs(sc, msg, plan)) {
+		pf.collect(&errs, pf.fakeFields(sc, msg, plan))
+	}
+	return errs.err()
 }
 
 func (pf *protoFaker) fakeOneofs(
-	depth int,
+	sc scope,
 	msg protoreflect.Message,
-	desc protoreflect.MessageDescriptor,
+	plan *messagePlan,
+) error {
+	var errs fieldErrors
+	for _, oneof := range plan.oneofs {
+		if !pf.collect(&errs, pf.fakeOneof(sc, msg, oneof)) {
+			break
+		}
+	}
+	return errs.err()
+}
+
+func (pf *protoFaker) fakeOneof(
+	sc scope,
+	msg protoreflect.Message,
+	oneof *oneofPlan,
 ) error {
-	oneofs := desc.Oneofs()
-	for i, n := 0, oneofs.Len(); i < n; i++ {
-		oneof := oneofs.Get(i)
-		fields := oneof.Fields()
-		idx := pf.faker.Rand.Intn(fields.Len()+1) - 1
-		if idx == -1 {
-			if field := msg.WhichOneof(oneof); field != nil {
-				msg.Clear(field)
+	if set := msg.WhichOneof(oneof.desc); set != nil && pf.fillMode != Overwrite {
+		for _, field := range oneof.fields {
+			if field.desc == set {
+				return pf.fakeField(sc, msg, field)
 			}
-			continue
 		}
-		if err := pf.fakeField(depth, msg, fields.Get(idx)); err != nil {
-			return err
+		return nil
+	}
+	idx := sc.field(string(oneof.desc.Name())).faker.Rand.Intn(len(oneof.fields)+1) - 1
+	if idx == -1 {
+		if field := msg.WhichOneof(oneof.desc); field != nil {
+			msg.Clear(field)
 		}
+		return nil
 	}
-	return nil
+	return pf.fakeField(sc, msg, oneof.fields[idx])
 }
 
 func (pf *protoFaker) fakeFields(
-	depth int,
+	sc scope,
 	msg protoreflect.Message,
-	desc protoreflect.MessageDescriptor,
+	plan *messagePlan,
 ) error {
-	fields := desc.Fields()
-	for i, n := 0, fields.Len(); i < n; i++ {
-		fdesc := fields.Get(i)
-		if fdesc.ContainingOneof() != nil {
-			continue
-		}
-		if err := pf.fakeField(depth, msg, fdesc); err != nil {
-			return err
+	var errs fieldErrors
+	for _, field := range plan.fields {
+		if !pf.collect(&errs, pf.fakeField(sc, msg, field)) {
+			break
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (pf *protoFaker) fakeField(
-	depth int,
+	sc scope,
 	msg protoreflect.Message,
-	desc protoreflect.FieldDescriptor,
+	field *fieldPlan,
 ) error {
-	gen, _ := proto.GetExtension(desc.Options(), pb.E_Generate).(*pb.Generator)
+	desc, gen := field.desc, field.gen
 	if gen.GetSkip() {
 		return nil
 	}
-	val, err := pf.fakeFieldValue(depth, msg.NewField(desc), desc, gen, false)
-	if err == nil && val.IsValid() {
+	sc = sc.field(string(desc.Name()))
+	sc.msg = msg
+	if pf.fillMode != Overwrite && msg.Has(desc) {
+		if err := pf.fillField(sc, msg, field); err != nil {
+			return fieldPathError(desc, gen, err)
+		}
+		return nil
+	}
+	return pf.replaceField(sc, msg, field)
+}
+
+// replaceField overwrites field on msg with a newly generated value,
+// regardless of the FillMode. The scope sc must be that of the field.
+func (pf *protoFaker) replaceField(
+	sc scope,
+	msg protoreflect.Message,
+	field *fieldPlan,
+) error {
+	desc, gen := field.desc, field.gen
+	sc.msg = msg
+	val, err := pf.fakeFieldValue(sc, msg.NewField(desc), desc, gen, false)
+	if err != nil {
+		return fieldPathError(desc, gen, err)
+	}
+	if val.IsValid() {
 		msg.Set(desc, val)
 	}
-	return err
+	return nil
 }
 
 func (pf *protoFaker) fakeFieldValue(
-	depth int,
+	sc scope,
 	val protoreflect.Value,
 	desc protoreflect.FieldDescriptor,
-	gen *pb.Generator,
+	gen *generatorPlan,
 	item bool,
 ) (protoreflect.Value, error) {
 	switch {
 	case gen.GetSkip():
 		return val, nil
+	case gen.GetCopyFrom() != "" && !item:
+		return pf.copyField(sc, val, desc, gen)
+	case gen.cel != nil && !item:
+		return pf.fakeCEL(sc, val, desc, gen)
 	case desc.IsMap():
-		return val, pf.fakeMap(depth, desc, gen, val.Map())
+		return val, pf.fakeMap(sc, desc, gen, val.Map())
 	case desc.IsList() && !item:
-		return val, pf.fakeList(depth, desc, gen, val.List())
+		return val, pf.fakeList(sc, desc, gen, val.List())
 	case desc.Kind() == protoreflect.MessageKind,
 		desc.Kind() == protoreflect.GroupKind:
 		switch desc.Message().FullName() {
 		case wktTimestampFQN,
 			wktDurationFQN:
-			return pf.fakeScalar(desc, gen)
+			return pf.fakeScalar(sc, desc, gen)
+		case wktAnyFQN:
+			if gen.GetTag() != "" || gen.GetTemplate() != "" || gen.GetPattern() != "" {
+				return pf.fakeAny(sc, val, desc, gen)
+			}
+			fallthrough
 		default:
-			if depth+1 >= pf.maxDepth {
+			if sc.depth+1 >= pf.maxDepth {
 				return protoreflect.Value{}, nil
 			}
-			return val, pf.fake(depth+1, val.Message())
+			return val, pf.fakeMessage(sc.nested(), val.Message())
 		}
 	default:
-		return pf.fakeScalar(desc, gen)
+		return pf.fakeScalar(sc, desc, gen)
+	}
+}
+
+// fakeAny populates the google.protobuf.Any in val with a fake message, whose
+// type name or URL is produced by the tag or template of gen.
+func (pf *protoFaker) fakeAny(
+	sc scope,
+	val protoreflect.Value,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) (protoreflect.Value, error) {
+	if sc.depth+1 >= pf.maxDepth {
+		return protoreflect.Value{}, nil
+	}
+	typeURL, err := pf.fakeString(sc, gen)
+	if err != nil {
+		return val, newFieldError(desc, gen, "", err)
+	}
+	msgType, err := sc.types.FindMessageByURL(typeURL)
+	if err != nil {
+		return val, newFieldError(desc, gen, typeURL, fmt.Errorf("failed to resolve Any type: %w", err))
+	}
+	inner := msgType.New()
+	if err = pf.fakeMessage(sc.nested(), inner); err != nil {
+		return val, err
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(inner.Interface())
+	if err != nil {
+		return val, err
+	}
+	anyMsg := val.Message()
+	fields := anyMsg.Descriptor().Fields()
+	if !strings.Contains(typeURL, "/") {
+		typeURL = anyTypeURLPrefix + typeURL
 	}
+	anyMsg.Set(fields.ByNumber(anyTypeURLFieldNumber), protoreflect.ValueOfString(typeURL))
+	anyMsg.Set(fields.ByNumber(anyValueFieldNumber), protoreflect.ValueOfBytes(data))
+	return val, nil
+}
+
+// generator resolves the Generator for a field from its annotation and any
+// configured overlay or rules, falling back to any buf.validate pattern rule
+// (see [WithValidatePatterns]) and then any matching smart default.
+func (pf *protoFaker) generator(desc protoreflect.FieldDescriptor) *pb.Generator {
+	gen, _ := proto.GetExtension(desc.Options(), pb.E_Generate).(*pb.Generator)
+	gen = pf.overlay.apply(desc.FullName(), gen)
+	if ruleGen := pf.matchRule(desc, gen != nil); ruleGen != nil {
+		return ruleGen
+	}
+	if gen == nil && pf.validatePattern {
+		gen = validatePattern(desc)
+	}
+	if gen == nil {
+		gen = pf.smartDefault(desc)
+	}
+	return gen
+}
+
+// addErr records a configuration error, returned from all calls to FakeProto.
+func (pf *protoFaker) addErr(err error) {
+	pf.err = errors.Join(pf.err, err)
+}
+
+func (pf *protoFaker) addOverlay(src *pb.Overlay, err error) {
+	if err != nil {
+		pf.addErr(err)
+		return
+	}
+	if pf.overlay == nil {
+		pf.overlay = &overlay{}
+	}
+	pf.addErr(pf.overlay.add(src))
+	pf.addRules(src.GetRules()...)
 }
 
 type sized interface {
@@ -236,96 +518,125 @@ type sized interface {
 	GetRange() *pb.Range
 }
 
-func (pf *protoFaker) fakeSize(msg sized, hasSizeOneof bool, def size) int {
+func (pf *protoFaker) fakeSize(sc scope, msg sized, hasSizeOneof bool, def size) int {
+	if pf.minimal {
+		return minimalSize(msg, hasSizeOneof)
+	}
+	if pf.edgeCase(sc) {
+		return pf.fakeEdgeSize(sc, msg, hasSizeOneof, def)
+	}
 	if !hasSizeOneof {
-		return def.Fake(pf.faker)
+		return def.Fake(sc.faker)
 	}
 	if rng := msg.GetRange(); rng != nil {
-		return pf.faker.IntRange(int(rng.GetMin()), int(rng.GetMax()))
+		return sc.faker.IntRange(int(rng.GetMin()), int(rng.GetMax()))
 	}
 	return int(msg.GetLen())
 }
 
 func (pf *protoFaker) fakeMap(
-	depth int,
+	sc scope,
 	desc protoreflect.FieldDescriptor,
-	gen *pb.Generator,
+	gen *generatorPlan,
 	mapVal protoreflect.Map,
-) (err error) {
+) error {
 	mapExt := gen.GetMap()
-	length := pf.fakeSize(mapExt, mapExt.GetSize() != nil, pf.mapSize)
+	length := pf.fakeSize(sc, mapExt, mapExt.GetSize() != nil, pf.mapSize)
 	if length == 0 {
 		return nil
 	}
 
 	kDesc, vDesc := desc.MapKey(), desc.MapValue()
-	kGen, vGen := mapExt.GetKey(), mapExt.GetValue()
-	if vGen == nil {
-		vGen = gen
-	}
+	kGen, vGen := gen.key, gen.value
 
-	for range length {
+	var errs fieldErrors
+	for i := range length {
+		entry := sc.index(i)
 		key := kDesc.Default()
 		if !kGen.GetSkip() {
-			key, err = pf.fakeScalar(kDesc, kGen)
-			if err != nil {
-				return err
+			var err error
+			if key, err = pf.fakeScalar(entry, kDesc, kGen); err != nil {
+				if !pf.collect(&errs, err) {
+					break
+				}
+				continue
 			}
 		}
-		val := mapVal.NewValue()
-		val, err = pf.fakeFieldValue(depth, val, vDesc, vGen, true)
+		val, err := pf.fakeFieldValue(entry, mapVal.NewValue(), vDesc, vGen, true)
 		if err != nil {
-			return err
+			if !pf.collect(&errs, keyPathError(key.MapKey(), err)) {
+				break
+			}
 		} else if val.IsValid() {
 			mapVal.Set(key.MapKey(), val)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (pf *protoFaker) fakeList(
-	depth int,
+	sc scope,
 	desc protoreflect.FieldDescriptor,
-	gen *pb.Generator,
+	gen *generatorPlan,
 	list protoreflect.List,
 ) error {
 	listExt := gen.GetRepeated()
-	length := pf.fakeSize(listExt, listExt.GetSize() != nil, pf.listSize)
+	length := pf.fakeSize(sc, listExt, listExt.GetSize() != nil, pf.listSize)
 	if length == 0 {
 		return nil
 	}
 
-	if elGen := listExt.GetElement(); elGen != nil {
-		gen = elGen
-	}
+	gen = gen.element
 
-	for range length {
-		val, err := pf.fakeFieldValue(depth, list.NewElement(), desc, gen, true)
+	var errs fieldErrors
+	for i := range length {
+		val, err := pf.fakeFieldValue(sc.index(i), list.NewElement(), desc, gen, true)
 		if err != nil {
-			return err
+			if !pf.collect(&errs, indexPathError(i, err)) {
+				break
+			}
 		} else if val.IsValid() {
 			list.Append(val)
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (pf *protoFaker) fakeScalar(
+	sc scope,
 	desc protoreflect.FieldDescriptor,
-	gen *pb.Generator,
+	gen *generatorPlan,
 ) (val protoreflect.Value, err error) {
+	if gen.bytes != nil && desc.Kind() == protoreflect.BytesKind {
+		return pf.fakeBytes(sc, desc, gen)
+	}
+	if gen.GetEmbedded() != nil && (desc.Kind() == protoreflect.StringKind || desc.Kind() == protoreflect.BytesKind) {
+		return pf.fakeEmbedded(sc, desc, gen)
+	}
+	if gen.GetTag() == "" && gen.GetTemplate() == "" && gen.GetPattern() == "" {
+		return pf.fakeFieldDefault(sc, desc, gen.strings), nil
+	}
+	s, err := pf.fakeString(sc, gen)
+	if err != nil {
+		return val, newFieldError(desc, gen, "", err)
+	}
+	if val, err = pf.fakeParse(desc, s); err != nil {
+		return val, newFieldError(desc, gen, s, err)
+	}
+	return val, nil
+}
+
+// fakeString produces the raw string result of the tag or template of gen.
+func (pf *protoFaker) fakeString(sc scope, gen *generatorPlan) (string, error) {
 	switch {
-	case gen.GetTag() != "":
-		s := pf.faker.Generate(gen.GetTag())
-		return pf.fakeParse(desc, s)
-	case gen.GetTemplate() != "":
-		s, err := pf.faker.Template(gen.GetTemplate(), pf.tplOptions)
-		if err != nil {
-			return val, err
-		}
-		return pf.fakeParse(desc, s)
+	case gen.tag != nil:
+		return gen.tag.generate(sc.faker), nil
+	case gen.pattern != nil:
+		return gen.pattern.generate(sc.faker), nil
+	case sc.msg == nil:
+		return gen.tpl.execute(sc.faker, nil, nil)
 	default:
-		return pf.fakeFieldDefault(desc), nil
+		return gen.tpl.execute(sc.faker, sc.msg, pf.plan(sc.msg.Descriptor()).refs)
 	}
 }
 
@@ -386,49 +697,61 @@ func (pf *protoFaker) fakeParse(desc protoreflect.FieldDescriptor, str string) (
 }
 
 //nolint:cyclop
-func (pf *protoFaker) fakeFieldDefault(desc protoreflect.FieldDescriptor) (val protoreflect.Value) {
+func (pf *protoFaker) fakeFieldDefault(
+	sc scope,
+	desc protoreflect.FieldDescriptor,
+	strs *stringPlan,
+) (val protoreflect.Value) {
+	if pf.edgeCase(sc) {
+		if val = pf.fakeEdgeCase(sc, desc, strs); val.IsValid() {
+			return val
+		}
+	}
 	if desc.Kind() == protoreflect.MessageKind {
 		switch desc.Message().FullName() {
 		case wktTimestampFQN:
-			ts := pf.faker.Date()
+			ts := sc.faker.Date()
 			return protoreflect.ValueOfMessage(timestamppb.New(ts).ProtoReflect())
 		case wktDurationFQN:
-			dur := time.Duration(pf.faker.Int64())
+			dur := time.Duration(sc.faker.Int64())
 			return protoreflect.ValueOfMessage(durationpb.New(dur).ProtoReflect())
 		}
 	}
 
 	switch desc.Kind() {
 	case protoreflect.BoolKind:
-		return protoreflect.ValueOf(pf.faker.Bool())
+		return protoreflect.ValueOf(sc.faker.Bool())
 	case protoreflect.EnumKind:
 		values := desc.Enum().Values()
-		i := pf.faker.IntRange(0, values.Len()-1)
+		i := sc.faker.IntRange(0, values.Len()-1)
 		return protoreflect.ValueOfEnum(values.Get(i).Number())
 	case protoreflect.Int32Kind,
 		protoreflect.Sint32Kind,
 		protoreflect.Sfixed32Kind:
-		return protoreflect.ValueOfInt32(pf.faker.Int32())
+		return protoreflect.ValueOfInt32(sc.faker.Int32())
 	case protoreflect.Uint32Kind,
 		protoreflect.Fixed32Kind:
-		return protoreflect.ValueOfUint32(pf.faker.Uint32())
+		return protoreflect.ValueOfUint32(sc.faker.Uint32())
 	case protoreflect.Int64Kind,
 		protoreflect.Sint64Kind,
 		protoreflect.Sfixed64Kind:
-		return protoreflect.ValueOfInt64(pf.faker.Int64())
+		return protoreflect.ValueOfInt64(sc.faker.Int64())
 	case protoreflect.Uint64Kind,
 		protoreflect.Fixed64Kind:
-		return protoreflect.ValueOfUint64(pf.faker.Uint64())
+		return protoreflect.ValueOfUint64(sc.faker.Uint64())
 	case protoreflect.FloatKind:
-		return protoreflect.ValueOfFloat32(pf.faker.Float32())
+		return protoreflect.ValueOfFloat32(sc.faker.Float32())
 	case protoreflect.DoubleKind:
-		return protoreflect.ValueOfFloat64(pf.faker.Float64())
+		return protoreflect.ValueOfFloat64(sc.faker.Float64())
 	case protoreflect.StringKind:
-		s := pf.faker.Generate(strings.Repeat("?", pf.stringSize.Fake(pf.faker)))
+		if strs != nil {
+			return protoreflect.ValueOfString(strs.generate(sc.faker))
+		}
+		s := sc.faker.Generate(strings.Repeat("?", pf.stringSize.Fake(sc.faker)))
 		return protoreflect.ValueOfString(s)
 	case protoreflect.BytesKind:
-		b := make([]byte, pf.bytesSize.Fake(pf.faker))
-		_, _ = pf.faker.Rand.Read(b)
+		b := make([]byte, pf.bytesSize.Fake(sc.faker))
+		_, _ = sc.faker.Rand.Read(b)
 		return protoreflect.ValueOfBytes(b)
 	case protoreflect.MessageKind,
 		protoreflect.GroupKind:
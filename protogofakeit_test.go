@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestProtoFaker(t *testing.T) {
@@ -402,6 +404,11 @@ func TestProtoFaker(t *testing.T) {
 			).FakeProto(custom)
 			require.NoError(t, err)
 			assert.True(t, custom.GetValue().IsValid())
+
+			overlay := `fields: {gofakeit.test.WKTTimestamp.tag: {tag: "1420070400"}}`
+			err = initProtoFaker(t, WithOverlay(strings.NewReader(overlay))).FakeProto(&test.WKTTimestamp{})
+			var fieldErr *FieldError
+			require.ErrorAs(t, err, &fieldErr, "integers are not timestamps")
 		})
 
 		t.Run("duration", func(t *testing.T) {
@@ -416,6 +423,37 @@ func TestProtoFaker(t *testing.T) {
 	})
 }
 
+func TestUnsupportedImplementation(t *testing.T) {
+	t.Parallel()
+
+	wrapped := struct{ ProtoFaker }{initProtoFaker(t)}
+	desc := (&test.ScalarDefaults{}).ProtoReflect().Descriptor()
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"bool"}}
+
+	tests := map[string]func() error{
+		"fake_by_name": func() error {
+			_, err := FakeByName(wrapped, desc.FullName(), nil)
+			return err
+		},
+		"compile":            func() error { return Compile(wrapped, desc) },
+		"fake_fields":        func() error { return FakeFields(wrapped, &test.ScalarDefaults{}, mask) },
+		"fake_fields_except": func() error { return FakeFieldsExcept(wrapped, &test.ScalarDefaults{}, mask) },
+		"mutate":             func() error { return Mutate(wrapped, &test.ScalarDefaults{}, 1) },
+		"generate_parallel": func() error {
+			return GenerateParallel(t.Context(), wrapped, 1, 1, func(int, *test.ScalarDefaults) error { return nil })
+		},
+	}
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorContains(t, fn(), "unsupported ProtoFaker implementation")
+		})
+	}
+
+	_, err := FakeDescriptor(wrapped, desc)
+	require.NoError(t, err)
+}
+
 func TestWithTemplateOptions(t *testing.T) {
 	t.Parallel()
 
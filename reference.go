@@ -0,0 +1,485 @@
+package protogofakeit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template/parse"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// reference is a dot-separated path to a field of a message, through its
+// nested message fields (e.g., "customer.email"). Paths traversing repeated
+// message fields refer to the field of each element (e.g.,
+// "line_items.price").
+type reference struct {
+	path []protoreflect.FieldDescriptor
+}
+
+func compileReference(desc protoreflect.MessageDescriptor, path string) (*reference, error) {
+	ref := &reference{}
+	for i, name := range strings.Split(path, ".") {
+		if i > 0 {
+			prev := ref.path[i-1]
+			if prev.IsMap() || prev.Message() == nil {
+				return nil, fmt.Errorf("%s is not a message field", prev.Name())
+			}
+			desc = prev.Message()
+		}
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("%s has no field %q", desc.FullName(), name)
+		}
+		ref.path = append(ref.path, field)
+	}
+	return ref, nil
+}
+
+// field returns the descriptor of the referenced field.
+func (ref *reference) field() protoreflect.FieldDescriptor {
+	return ref.path[len(ref.path)-1]
+}
+
+// plural reports whether the reference traverses a repeated field, referring
+// to the field of each element.
+func (ref *reference) plural() bool {
+	for _, field := range ref.path[:len(ref.path)-1] {
+		if field.IsList() {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the values of the referenced field within msg, one for each
+// element of any repeated fields traversed. Unset singular fields have their
+// default value.
+func (ref *reference) get(msg protoreflect.Message) []protoreflect.Value {
+	msgs := []protoreflect.Message{msg}
+	for _, field := range ref.path[:len(ref.path)-1] {
+		var next []protoreflect.Message
+		for _, m := range msgs {
+			if !field.IsList() {
+				next = append(next, m.Get(field).Message())
+				continue
+			}
+			list := m.Get(field).List()
+			for i := range list.Len() {
+				next = append(next, list.Get(i).Message())
+			}
+		}
+		msgs = next
+	}
+	out := make([]protoreflect.Value, 0, len(msgs))
+	for _, m := range msgs {
+		out = append(out, m.Get(ref.field()))
+	}
+	return out
+}
+
+// templateData is the data of executed templates, exposing the fields of the
+// message being generated alongside the data of the TemplateOptions.
+type templateData struct {
+	*gofakeit.TemplateOptions
+
+	msg protoreflect.Message
+	// refs are the compiled references of the message, by path.
+	refs map[string]*reference
+}
+
+// Field returns the value of the field of the message at path (see
+// reference). Lists are returned as slices, enums as numbers, bytes as
+// strings, timestamps as time.Time, durations as time.Duration, and other
+// messages as proto.Message.
+func (data *templateData) Field(path string) (any, error) {
+	if data.msg == nil {
+		return nil, errors.New("no message to reference")
+	}
+	// Only literal paths are compiled ahead of time.
+	ref, ok := data.refs[path]
+	if !ok {
+		var err error
+		if ref, err = compileReference(data.msg.Descriptor(), path); err != nil {
+			return nil, err
+		}
+	}
+	vals := ref.get(data.msg)
+	if !ref.plural() {
+		return templateValue(ref.field(), vals[0], false), nil
+	}
+	var out []any
+	for _, val := range vals {
+		item := templateValue(ref.field(), val, false)
+		if items, ok := item.([]any); ok && ref.field().IsList() {
+			out = append(out, items...)
+		} else {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+// Sum returns the sum of the numeric field at path, or of its values if it is
+// repeated or traverses a repeated field. The sum is an int64, unless the
+// field is a float or double.
+func (data *templateData) Sum(path string) (any, error) {
+	val, err := data.Field(path)
+	if err != nil {
+		return nil, err
+	}
+	vals, ok := val.([]any)
+	if !ok {
+		vals = []any{val}
+	}
+	var sum int64
+	var fsum float64
+	isFloat := false
+	for _, val := range vals {
+		switch val := val.(type) {
+		case int32:
+			sum += int64(val)
+		case int64:
+			sum += val
+		case uint32:
+			sum += int64(val)
+		case uint64:
+			sum += int64(val) //nolint:gosec // sums beyond an int64 are not supported
+		case float32:
+			fsum += float64(val)
+			isFloat = true
+		case float64:
+			fsum += val
+			isFloat = true
+		default:
+			return nil, fmt.Errorf("%s is not a numeric field", path)
+		}
+	}
+	if isFloat {
+		return fsum + float64(sum), nil
+	}
+	return sum, nil
+}
+
+// templateValue converts val of the field desc to its template
+// representation. If item is true, val is an element of a list.
+func templateValue(desc protoreflect.FieldDescriptor, val protoreflect.Value, item bool) any {
+	switch {
+	case desc.IsMap():
+		out := map[any]any{}
+		val.Map().Range(func(key protoreflect.MapKey, mapVal protoreflect.Value) bool {
+			out[key.Interface()] = templateValue(desc.MapValue(), mapVal, true)
+			return true
+		})
+		return out
+	case desc.IsList() && !item:
+		list := val.List()
+		out := make([]any, 0, list.Len())
+		for i := range list.Len() {
+			out = append(out, templateValue(desc, list.Get(i), true))
+		}
+		return out
+	}
+	switch desc.Kind() {
+	case protoreflect.EnumKind:
+		return int32(val.Enum())
+	case protoreflect.BytesKind:
+		return string(val.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := val.Message()
+		fields := msg.Descriptor().Fields()
+		switch msg.Descriptor().FullName() {
+		case wktTimestampFQN:
+			return time.Unix(msg.Get(fields.ByNumber(1)).Int(), msg.Get(fields.ByNumber(2)).Int()).UTC()
+		case wktDurationFQN:
+			return time.Duration(msg.Get(fields.ByNumber(1)).Int())*time.Second +
+				time.Duration(msg.Get(fields.ByNumber(2)).Int())
+		default:
+			return msg.Interface()
+		}
+	default:
+		return val.Interface()
+	}
+}
+
+// copyField returns a copy of the field referenced by the copy_from option of
+// gen, to be stored in val, a new value of the field desc. An invalid value is
+// returned if the referenced field is unset.
+func (pf *protoFaker) copyField(
+	sc scope,
+	val protoreflect.Value,
+	desc protoreflect.FieldDescriptor,
+	gen *generatorPlan,
+) (protoreflect.Value, error) {
+	ref, ok := pf.plan(sc.msg.Descriptor()).refs[gen.GetCopyFrom()]
+	if !ok {
+		err := fmt.Errorf("invalid reference %q", gen.GetCopyFrom())
+		return val, newFieldError(desc, gen, "", err)
+	}
+	owner := sc.msg
+	for _, field := range ref.path[:len(ref.path)-1] {
+		owner = owner.Get(field).Message()
+	}
+	if !owner.Has(ref.field()) {
+		return protoreflect.Value{}, nil
+	}
+	src := owner.Get(ref.field())
+	switch {
+	case desc.IsMap():
+		dst := val.Map()
+		src.Map().Range(func(key protoreflect.MapKey, mapVal protoreflect.Value) bool {
+			dst.Set(key, copyValue(desc.MapValue(), mapVal, dst.NewValue()))
+			return true
+		})
+	case desc.IsList():
+		dst, list := val.List(), src.List()
+		for i := range list.Len() {
+			dst.Append(copyValue(desc, list.Get(i), dst.NewElement()))
+		}
+	default:
+		return copyValue(desc, src, val), nil
+	}
+	return val, nil
+}
+
+// copyValue copies the singular value src of desc. Messages are merged into
+// dst, a new value of the field.
+func copyValue(desc protoreflect.FieldDescriptor, src, dst protoreflect.Value) protoreflect.Value {
+	switch desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		proto.Merge(dst.Message().Interface(), src.Message().Interface())
+		return dst
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(append([]byte(nil), src.Bytes()...))
+	default:
+		return src
+	}
+}
+
+// references returns the paths referenced by gen via copy_from or cel, or by
+// the templates of it and its list elements and map keys and values.
+func (gen *generatorPlan) references() []string {
+	var out []string
+	if path := gen.GetCopyFrom(); path != "" {
+		out = append(out, path)
+	}
+	if gen.tpl != nil {
+		out = append(out, gen.tpl.refs...)
+	}
+	if gen.cel != nil {
+		out = append(out, gen.cel.refs...)
+	}
+	if gen.bytes != nil && gen.bytes.source != nil {
+		out = append(out, gen.bytes.source.references()...)
+	}
+	for _, nested := range []*generatorPlan{gen.element, gen.key, gen.value} {
+		if nested != nil && nested != gen {
+			out = append(out, nested.references()...)
+		}
+	}
+	return out
+}
+
+// derived reports whether the field is computed from other fields of its
+// message, via copy_from, cel, or references within templates.
+func (field *fieldPlan) derived() bool {
+	return field.gen.cel != nil || len(field.gen.references()) > 0
+}
+
+// templateReferences returns the literal paths passed to the Field and Sum
+// methods of the data within the parse trees.
+func templateReferences(trees ...*parse.Tree) []string {
+	var out []string
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch node := node.(type) {
+		case *parse.ListNode:
+			if node == nil {
+				return
+			}
+			for _, n := range node.Nodes {
+				walk(n)
+			}
+		case *parse.ActionNode:
+			walk(node.Pipe)
+		case *parse.IfNode:
+			walk(&node.BranchNode)
+		case *parse.RangeNode:
+			walk(&node.BranchNode)
+		case *parse.WithNode:
+			walk(&node.BranchNode)
+		case *parse.BranchNode:
+			walk(node.Pipe)
+			walk(node.List)
+			walk(node.ElseList)
+		case *parse.TemplateNode:
+			walk(node.Pipe)
+		case *parse.PipeNode:
+			if node == nil {
+				return
+			}
+			for _, cmd := range node.Cmds {
+				walk(cmd)
+			}
+		case *parse.ChainNode:
+			walk(node.Node)
+		case *parse.CommandNode:
+			if len(node.Args) > 1 && isReferenceMethod(node.Args[0]) {
+				if str, ok := node.Args[1].(*parse.StringNode); ok {
+					out = append(out, str.Text)
+				}
+			}
+			for _, arg := range node.Args {
+				walk(arg)
+			}
+		default:
+		}
+	}
+	for _, tree := range trees {
+		if tree != nil {
+			walk(tree.Root)
+		}
+	}
+	return out
+}
+
+// isReferenceMethod reports whether node is .Field or .Sum (or $.Field or
+// $.Sum).
+func isReferenceMethod(node parse.Node) bool {
+	var ident []string
+	switch node := node.(type) {
+	case *parse.FieldNode:
+		ident = node.Ident
+	case *parse.VariableNode:
+		if len(node.Ident) == 0 || node.Ident[0] != "$" {
+			return false
+		}
+		ident = node.Ident[1:]
+	default:
+		return false
+	}
+	return len(ident) == 1 && (ident[0] == "Field" || ident[0] == "Sum")
+}
+
+// orderFields returns the fields of plan outside of oneofs, sorted such that
+// each follows the fields it references, along with any issues with the
+// references of the fields of desc. Fields within oneofs, which are generated
+// first, may only reference fields of preceding oneofs.
+func orderFields(desc protoreflect.MessageDescriptor, plan *messagePlan) ([]*fieldPlan, []Issue) {
+	var issues []Issue
+	addf := func(field protoreflect.FieldDescriptor, format string, args ...any) {
+		issues = append(issues, Issue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	deps := map[protoreflect.Name][]protoreflect.Name{}
+	fields := desc.Fields()
+	for i, n := 0, fields.Len(); i < n; i++ {
+		fdesc := fields.Get(i)
+		field := plan.byName[fdesc.Name()]
+		for _, path := range field.gen.references() {
+			ref, err := compileReference(desc, path)
+			if err != nil {
+				addf(fdesc, "invalid reference %q: %v", path, err)
+				continue
+			}
+			plan.refs[path] = ref
+			target := ref.path[0]
+			if oneof := fdesc.ContainingOneof(); oneof != nil {
+				if other := target.ContainingOneof(); other == nil || other.Index() >= oneof.Index() {
+					addf(fdesc, "cannot reference %s, which is generated after it", target.Name())
+				}
+			} else if target.ContainingOneof() == nil {
+				deps[fdesc.Name()] = append(deps[fdesc.Name()], target.Name())
+			}
+		}
+		if path := field.gen.GetCopyFrom(); path != "" {
+			if ref, err := compileReference(desc, path); err == nil {
+				if msg := copyMismatch(fdesc, ref); msg != "" {
+					addf(fdesc, "copy_from %q %s", path, msg)
+				}
+			}
+		}
+	}
+
+	// Visit the fields depth-first in declaration order, appending each after
+	// its dependencies, such that fields without references retain their
+	// order.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[protoreflect.Name]int{}
+	ordered := make([]*fieldPlan, 0, len(plan.fields))
+	var stack []protoreflect.Name
+	var visit func(name protoreflect.Name)
+	visit = func(name protoreflect.Name) {
+		switch state[name] {
+		case visited:
+			return
+		case visiting:
+			start := 0
+			for i, prev := range stack {
+				if prev == name {
+					start = i
+					break
+				}
+			}
+			cycle := make([]string, 0, len(stack)-start+1)
+			for _, prev := range stack[start:] {
+				cycle = append(cycle, string(prev))
+			}
+			cycle = append(cycle, string(name))
+			addf(fields.ByName(name), "reference cycle: %s", strings.Join(cycle, " -> "))
+			return
+		default:
+		}
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, dep := range deps[name] {
+			visit(dep)
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+		ordered = append(ordered, plan.byName[name])
+	}
+	for i, n := 0, fields.Len(); i < n; i++ {
+		if fdesc := fields.Get(i); fdesc.ContainingOneof() == nil {
+			visit(fdesc.Name())
+		}
+	}
+	return ordered, issues
+}
+
+// copyMismatch describes why the field referenced by ref cannot be copied to
+// desc, or returns an empty string if it can.
+func copyMismatch(desc protoreflect.FieldDescriptor, ref *reference) string {
+	src := ref.field()
+	switch {
+	case ref.plural():
+		return "traverses a repeated field"
+	case src.IsMap() != desc.IsMap(), src.IsList() != desc.IsList():
+		return "differs in cardinality"
+	case src.IsMap():
+		if kindName(src.MapKey()) != kindName(desc.MapKey()) || !sameType(src.MapValue(), desc.MapValue()) {
+			return fmt.Sprintf("has type map of %s, not map of %s", kindName(src.MapValue()), kindName(desc.MapValue()))
+		}
+	case !sameType(src, desc):
+		return fmt.Sprintf("has type %s, not %s", kindName(src), kindName(desc))
+	}
+	return ""
+}
+
+// sameType reports whether the values of a and b have the same type.
+func sameType(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() {
+		return false
+	}
+	if a.Enum() != nil {
+		return a.Enum().FullName() == b.Enum().FullName()
+	}
+	return kindName(a) == kindName(b)
+}
@@ -0,0 +1,72 @@
+package protogofakeit
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"text/template"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReferences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("derived", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		for range 20 {
+			msg := &test.Order{}
+			require.NoError(t, pf.FakeProto(msg))
+
+			customer := msg.GetCustomer()
+			assert.Equal(t, customer.GetFirstName()+" "+customer.GetLastName(), msg.GetDisplayName())
+			assert.Regexp(t, `^[a-z]+@example\.com$`, msg.GetEmail())
+			assert.False(t, msg.GetUpdatedAt().AsTime().Before(msg.GetCreatedAt().AsTime().Truncate(1e9)))
+
+			var total float64
+			var quantity int64
+			for _, item := range msg.GetLineItems() {
+				total += item.GetPrice()
+				quantity += int64(item.GetQuantity())
+			}
+			assert.InDelta(t, total, msg.GetTotal(), 1e-6)
+			assert.Equal(t, quantity, msg.GetQuantity())
+
+			assert.True(t, proto.Equal(customer, msg.GetBilling()))
+			assert.NotSame(t, customer, msg.GetBilling())
+			assert.Equal(t, msg.GetTags(), msg.GetTagsCopy())
+			assert.Equal(t, customer.GetFirstName(), msg.GetFirstName())
+		}
+	})
+
+	t.Run("compiled_once", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		plan := pf.(*protoFaker).plan((&test.Order{}).ProtoReflect().Descriptor())
+		require.NoError(t, plan.err)
+		assert.ElementsMatch(t, []string{
+			"customer.first_name", "customer.last_name", "created_at",
+			"line_items.price", "line_items.quantity", "customer", "tags",
+		}, slices.Collect(maps.Keys(plan.refs)))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+		err := initProtoFaker(t).FakeProto(&test.Invalid{})
+		require.ErrorContains(t, err, "reference cycle: cycle_a -> cycle_b -> cycle_a")
+	})
+}
+
+func TestTemplateReferences(t *testing.T) {
+	t.Parallel()
+
+	tpl := template.Must(template.New("").Funcs(template.FuncMap{"ToLower": func(string) string { return "" }}).Parse(
+		`{{ .Field "a" }}{{ if .Field "b.c" }}{{ ToLower ($.Field "d") }}{{ end }}` +
+			`{{ range .Field "e" }}{{ . }}{{ end }}{{ .Sum "f" }}{{ .Data.Field }}{{ $x := "g" }}{{ .Field $x }}`,
+	))
+	assert.Equal(t, []string{"a", "b.c", "d", "e", "f"}, templateReferences(tpl.Tree))
+}
@@ -0,0 +1,187 @@
+package protogofakeit
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WithRules applies generators to all fields matching the conditions of the
+// provided gofakeit.Rule values, such as a field name pattern, kind, or
+// containing message. Rules may also be loaded from a config file via the
+// rules of an overlay (see [WithOverlay]).
+//
+// Rules are evaluated in the order they are added, with the first matching
+// rule applied. By default, explicit generators from annotations or overlay
+// fields take precedence over rules, unless the rule sets override. Rules
+// take precedence over smart defaults (see [WithSmartDefaults]).
+//
+// Any invalid rule condition is returned from every call to
+// [ProtoFaker.FakeProto], identified by its index among all rules added by
+// every WithRules call and overlay, in order.
+func WithRules(rules ...*pb.Rule) Option {
+	return optionFunc(func(pf *protoFaker) {
+		pf.addRules(rules...)
+	})
+}
+
+// rule is a compiled gofakeit.Rule.
+type rule struct {
+	src   *pb.Rule
+	name  *regexp.Regexp
+	kinds map[protoreflect.Kind]struct{}
+}
+
+func newRule(src *pb.Rule) (rule, error) {
+	out := rule{src: src}
+	var errs []error
+	if src.GetName() != "" {
+		re, err := regexp.Compile(src.GetName())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid name pattern: %w", err))
+		}
+		out.name = re
+	}
+	if len(src.GetKinds()) > 0 {
+		out.kinds = make(map[protoreflect.Kind]struct{}, len(src.GetKinds()))
+		for _, name := range src.GetKinds() {
+			kind, ok := kindByName(name)
+			if !ok {
+				errs = append(errs, fmt.Errorf("unknown kind %q", name))
+			}
+			out.kinds[kind] = struct{}{}
+		}
+	}
+	for _, glob := range []struct{ label, pattern string }{
+		{"message", src.GetMessage()},
+		{"package", src.GetPackage()},
+		{"file", src.GetFile()},
+		{"type", src.GetType()},
+	} {
+		if _, err := path.Match(glob.pattern, ""); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s pattern %q: %w", glob.label, glob.pattern, err))
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+func (r rule) matches(desc protoreflect.FieldDescriptor) bool {
+	if r.name != nil && !r.name.MatchString(string(desc.Name())) {
+		return false
+	}
+	if r.kinds != nil {
+		if _, ok := r.kinds[desc.Kind()]; !ok {
+			return false
+		}
+	}
+	return r.matchesCardinality(desc) &&
+		globMatch(r.src.GetMessage(), string(desc.ContainingMessage().FullName())) &&
+		globMatch(r.src.GetPackage(), string(desc.ParentFile().Package())) &&
+		globMatch(r.src.GetFile(), desc.ParentFile().Path()) &&
+		r.matchesType(desc) &&
+		r.matchesOptions(desc)
+}
+
+func (r rule) matchesCardinality(desc protoreflect.FieldDescriptor) bool {
+	switch r.src.GetCardinality() {
+	case pb.Cardinality_CARDINALITY_SINGULAR:
+		return !desc.IsList() && !desc.IsMap()
+	case pb.Cardinality_CARDINALITY_REPEATED:
+		return desc.IsList()
+	case pb.Cardinality_CARDINALITY_MAP:
+		return desc.IsMap()
+	case pb.Cardinality_CARDINALITY_UNSPECIFIED:
+		fallthrough
+	default:
+		return true
+	}
+}
+
+func (r rule) matchesType(desc protoreflect.FieldDescriptor) bool {
+	if r.src.GetType() == "" {
+		return true
+	}
+	switch {
+	case desc.IsMap():
+		return false
+	case desc.Message() != nil:
+		return globMatch(r.src.GetType(), string(desc.Message().FullName()))
+	case desc.Enum() != nil:
+		return globMatch(r.src.GetType(), string(desc.Enum().FullName()))
+	default:
+		return false
+	}
+}
+
+func (r rule) matchesOptions(desc protoreflect.FieldDescriptor) bool {
+	if len(r.src.GetOptions()) == 0 {
+		return true
+	}
+	set := make(map[string]struct{})
+	if opts, ok := desc.Options().(interface{ ProtoReflect() protoreflect.Message }); ok {
+		opts.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fd.IsExtension() {
+				set[string(fd.FullName())] = struct{}{}
+			} else {
+				set[string(fd.Name())] = struct{}{}
+			}
+			return true
+		})
+	}
+	for _, opt := range r.src.GetOptions() {
+		if _, ok := set[opt]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// matchRule returns the generator of the first rule matching desc, or nil if
+// none match. If annotated is true, only overriding rules are considered.
+func (pf *protoFaker) matchRule(desc protoreflect.FieldDescriptor, annotated bool) *pb.Generator {
+	for _, r := range pf.rules {
+		if annotated && !r.src.GetOverride() {
+			continue
+		}
+		if r.matches(desc) {
+			return r.src.GetGenerate()
+		}
+	}
+	return nil
+}
+
+func (pf *protoFaker) addRules(rules ...*pb.Rule) {
+	for _, src := range rules {
+		idx := pf.numRules
+		pf.numRules++
+		r, err := newRule(src)
+		if err != nil {
+			pf.addErr(fmt.Errorf("invalid rule at index %d: %w", idx, err))
+			continue
+		}
+		pf.rules = append(pf.rules, r)
+	}
+}
+
+// globMatch reports whether name matches pattern, with an empty pattern
+// matching everything.
+func globMatch(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
+
+func kindByName(name string) (protoreflect.Kind, bool) {
+	for kind := protoreflect.Kind(1); kind <= protoreflect.Sint64Kind; kind++ {
+		if kind.String() == name {
+			return kind, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,139 @@
+package protogofakeit
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRules(t *testing.T) {
+	t.Parallel()
+
+	tag := func(tag string) *pb.Generator {
+		return &pb.Generator{Apply: &pb.Generator_Tag{Tag: tag}}
+	}
+
+	t.Run("conditions", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name  string
+			rule  *pb.Rule
+			match bool
+		}{
+			{"empty", &pb.Rule{}, true},
+			{"name", &pb.Rule{Name: "^str"}, true},
+			{"name_mismatch", &pb.Rule{Name: "^bytes$"}, false},
+			{"kind", &pb.Rule{Kinds: []string{"bytes", "string"}}, true},
+			{"kind_mismatch", &pb.Rule{Kinds: []string{"int32"}}, false},
+			{"cardinality", &pb.Rule{Cardinality: pb.Cardinality_CARDINALITY_SINGULAR}, true},
+			{"cardinality_mismatch", &pb.Rule{Cardinality: pb.Cardinality_CARDINALITY_REPEATED}, false},
+			{"message", &pb.Rule{Message: "*.ScalarDefaults"}, true},
+			{"message_mismatch", &pb.Rule{Message: "*.ScalarTags"}, false},
+			{"package", &pb.Rule{Package: "gofakeit.*"}, true},
+			{"package_mismatch", &pb.Rule{Package: "acme.*"}, false},
+			{"file", &pb.Rule{File: "gofakeit/test/*.proto"}, true},
+			{"file_mismatch", &pb.Rule{File: "acme/*.proto"}, false},
+			{"type_mismatch", &pb.Rule{Type: "*"}, false},
+			{"options_mismatch", &pb.Rule{Options: []string{"deprecated"}}, false},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+				if tc.rule.GetName() == "" {
+					tc.rule.Name = "^string$"
+				}
+				tc.rule.Generate = tag("matched")
+				msg := &test.ScalarDefaults{}
+				err := initProtoFaker(t, WithRules(tc.rule)).FakeProto(msg)
+				require.NoError(t, err)
+				if tc.match {
+					assert.Equal(t, "matched", msg.GetString_())
+				} else {
+					assert.NotEqual(t, "matched", msg.GetString_())
+				}
+			})
+		}
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.RepeatedDefaults{}
+		err := initProtoFaker(t, WithMaxDepth(2), WithRules(&pb.Rule{
+			Message:     "gofakeit.test.RepeatedDefaults",
+			Cardinality: pb.Cardinality_CARDINALITY_REPEATED,
+			Type:        "gofakeit.test.Repeated*",
+			Generate: &pb.Generator{Apply: &pb.Generator_Repeated{
+				Repeated: &pb.Repeated{Size: &pb.Repeated_Len{Len: 0}},
+			}},
+		})).FakeProto(msg)
+		require.NoError(t, err)
+		sliceInDefault(t, msg.GetScalars())
+		assert.Empty(t, msg.GetEnums())
+		assert.Empty(t, msg.GetMessages())
+		assert.Empty(t, msg.GetRecursive())
+	})
+
+	t.Run("precedence", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.ScalarStaticTags{}
+		err := initProtoFaker(t, WithRules(
+			&pb.Rule{Name: "^string$", Generate: tag("rule")},
+			&pb.Rule{Name: "^bytes$", Generate: tag("override"), Override: true},
+			&pb.Rule{Name: "^bytes$", Generate: tag("shadowed"), Override: true},
+		)).FakeProto(msg)
+		require.NoError(t, err)
+		assert.Equal(t, "foobar", msg.GetString_(), "annotation should win")
+		assert.Equal(t, []byte("override"), msg.GetBytes(), "first overriding rule should win")
+
+		smart := &test.SmartDefaults{}
+		err = initProtoFaker(t,
+			WithSmartDefaults(),
+			WithRules(&pb.Rule{Name: "^email$", Generate: tag("rule")}),
+		).FakeProto(smart)
+		require.NoError(t, err)
+		assert.Equal(t, "rule", smart.GetEmail(), "rule should beat smart defaults")
+	})
+
+	t.Run("overlay", func(t *testing.T) {
+		t.Parallel()
+		overlay := `
+rules:
+  - name: "^string$"
+    kinds: [string]
+    generate:
+      tag: from-overlay
+`
+		msg := &test.ScalarDefaults{}
+		err := initProtoFaker(t, WithOverlay(strings.NewReader(overlay))).FakeProto(msg)
+		require.NoError(t, err)
+		assert.Equal(t, "from-overlay", msg.GetString_())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		err := initProtoFaker(t, WithRules(&pb.Rule{
+			Name:    "(",
+			Kinds:   []string{"strings"},
+			Message: "[",
+		})).FakeProto(&test.ScalarDefaults{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid name pattern")
+		assert.Contains(t, err.Error(), `unknown kind "strings"`)
+		assert.Contains(t, err.Error(), `invalid message pattern "["`)
+
+		overlay := `rules: [{name: "["}]`
+		err = initProtoFaker(t,
+			WithRules(&pb.Rule{Name: "valid"}, &pb.Rule{Name: ")"}),
+			WithOverlay(strings.NewReader(overlay)),
+		).FakeProto(&test.ScalarDefaults{})
+		require.ErrorContains(t, err, "invalid rule at index 1")
+		require.ErrorContains(t, err, "invalid rule at index 2")
+		assert.NotContains(t, err.Error(), "invalid rule at index 0")
+	})
+}
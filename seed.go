@@ -0,0 +1,75 @@
+package protogofakeit
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// WithStableSeeding derives an independent random stream for each field from
+// a hash of the field's path within the message (including list and map
+// indices), seeded once per call from the configured faker. Existing fields
+// retain their values when unrelated fields are added, removed, or reordered,
+// keeping golden files stable as schemas evolve. By default, all fields
+// consume a single shared random stream in declaration order.
+func WithStableSeeding() Option {
+	return optionFunc(func(pf *protoFaker) {
+		pf.stableSeeding = true
+	})
+}
+
+// field returns the scope of the named field or oneof within the current
+// message.
+func (sc scope) field(name string) scope {
+	return sc.derive(name)
+}
+
+// index returns the scope of the i-th element of a list or map.
+func (sc scope) index(i int) scope {
+	return sc.derive(strconv.Itoa(i))
+}
+
+// derive returns a child scope with its own random stream if stable seeding
+// is enabled; otherwise, the scope is returned unchanged.
+func (sc scope) derive(key string) scope {
+	if !sc.stable {
+		return sc
+	}
+	hash := fnv.New64a()
+	_ = binary.Write(hash, binary.LittleEndian, sc.seed)
+	_, _ = hash.Write([]byte(key))
+	sc.seed = mix64(hash.Sum64())
+	sc.faker = gofakeit.NewCustom(&splitMix64{state: sc.seed})
+	return sc
+}
+
+// splitMix64Gamma is the increment between successive SplitMix64 states.
+const splitMix64Gamma = 0x9e3779b97f4a7c15
+
+// splitMix64 is a small, fast rand.Source64 suitable for the many short-lived
+// random streams created by stable seeding.
+type splitMix64 struct {
+	state uint64
+}
+
+func (s *splitMix64) Seed(seed int64) {
+	s.state = uint64(seed) //nolint:gosec // reinterpreting the bits is intended
+}
+
+func (s *splitMix64) Uint64() uint64 {
+	s.state += splitMix64Gamma
+	return mix64(s.state)
+}
+
+func (s *splitMix64) Int63() int64 {
+	return int64(s.Uint64() >> 1) //nolint:gosec // the shifted value fits in 63 bits
+}
+
+// mix64 is the SplitMix64 finalizer, scrambling the bits of z.
+func mix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,83 @@
+package protogofakeit
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestWithStableSeeding(t *testing.T) {
+	t.Parallel()
+
+	t.Run("deterministic", func(t *testing.T) {
+		t.Parallel()
+		for _, msg := range []proto.Message{
+			&test.ScalarDefaults{},
+			&test.RepeatedDefaults{},
+			&test.MapDefaults{},
+			&test.SelfRecursive{},
+		} {
+			a, b := msg, proto.Clone(msg)
+			require.NoError(t, initProtoFaker(t, WithStableSeeding(), withSeed(42)).FakeProto(a))
+			require.NoError(t, initProtoFaker(t, WithStableSeeding(), withSeed(42)).FakeProto(b))
+			assert.True(t, proto.Equal(a, b), "%T", msg)
+		}
+	})
+
+	t.Run("successive_calls", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithStableSeeding())
+		a, b := &test.ScalarDefaults{}, &test.ScalarDefaults{}
+		require.NoError(t, pf.FakeProto(a))
+		require.NoError(t, pf.FakeProto(b))
+		assert.False(t, proto.Equal(a, b))
+	})
+
+	t.Run("schema_changes", func(t *testing.T) {
+		t.Parallel()
+
+		// Remove the first field, reverse the remaining, and add a new one.
+		fdp := protodesc.ToFileDescriptorProto(test.File_gofakeit_test_scalar_proto)
+		idx := slices.IndexFunc(fdp.GetMessageType(), func(msg *descriptorpb.DescriptorProto) bool {
+			return msg.GetName() == "ScalarDefaults"
+		})
+		msgDesc := fdp.GetMessageType()[idx]
+		fields := slices.Clone(msgDesc.GetField()[1:])
+		slices.Reverse(fields)
+		msgDesc.Field = append([]*descriptorpb.FieldDescriptorProto{{
+			Name:     proto.String("extra"),
+			JsonName: proto.String("extra"),
+			Number:   proto.Int32(100),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}}, fields...)
+		file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+		require.NoError(t, err)
+		changed := file.Messages().ByName("ScalarDefaults")
+
+		original := &test.ScalarDefaults{}
+		require.NoError(t, initProtoFaker(t, WithStableSeeding(), withSeed(42)).FakeProto(original))
+		modified, err := FakeDescriptor(initProtoFaker(t, WithStableSeeding(), withSeed(42)), changed)
+		require.NoError(t, err)
+
+		origFields := original.ProtoReflect().Descriptor().Fields()
+		for i := range origFields.Len() {
+			fd := origFields.Get(i)
+			modFD := changed.Fields().ByName(fd.Name())
+			if modFD == nil {
+				continue
+			}
+			assert.Equal(t,
+				original.ProtoReflect().Get(fd).Interface(),
+				modified.Get(modFD).Interface(),
+				fd.Name())
+		}
+	})
+}
@@ -0,0 +1,277 @@
+package protogofakeit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SmartDefault maps unannotated fields to a generator based on their name and
+// type. See [WithSmartDefaults].
+type SmartDefault struct {
+	// Names matches fields named exactly one of these values.
+	Names []string
+	// Suffixes matches fields whose name ends with one of these values.
+	Suffixes []string
+	// Kinds restricts matches to scalar fields of these kinds.
+	Kinds []protoreflect.Kind
+	// Message restricts matches to message fields of this type.
+	Message protoreflect.FullName
+	// Generator is applied to matching fields. For repeated fields, it is
+	// applied to each element.
+	Generator *pb.Generator
+
+	// dates, if set, are the first and last dates of the timestamps of the
+	// rule, used in place of Generator to format them per
+	// [WithTimestampFormat].
+	dates [2]string
+}
+
+// WithSmartDefaults enables generating realistic values for fields without a
+// Generator (either from an annotation or overlay) by matching their names and
+// types against rules. Names and suffixes are written in snake_case and are
+// compared word-by-word against both the field's name and JSON name,
+// ignoring case: the suffix "email" matches "work_email" and "workEmail" but
+// not "gmail". Repeated fields are also matched by the singular form of their
+// name (e.g., "emails"). The first matching rule is used.
+//
+// If no rules are provided, [DefaultSmartDefaults] is used. To extend the
+// default rules, pass them alongside any custom rules:
+//
+//	WithSmartDefaults(append(custom, DefaultSmartDefaults()...)...)
+func WithSmartDefaults(rules ...SmartDefault) Option {
+	return optionFunc(func(pf *protoFaker) {
+		if len(rules) == 0 {
+			rules = DefaultSmartDefaults()
+		}
+		pf.smartDefaults = make([]smartDefault, len(rules))
+		for i, rule := range rules {
+			pf.smartDefaults[i] = newSmartDefault(rule)
+		}
+	})
+}
+
+// DefaultSmartDefaults returns the rules used by [WithSmartDefaults] when none
+// are provided, covering common names for people, contact information,
+// addresses, internet identifiers, coordinates, ages, and timestamps. The
+// timestamps are formatted per [WithTimestampFormat].
+func DefaultSmartDefaults() []SmartDefault {
+	str := []protoreflect.Kind{protoreflect.StringKind}
+	ints := []protoreflect.Kind{
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+	}
+	floats := []protoreflect.Kind{protoreflect.FloatKind, protoreflect.DoubleKind}
+	tag := func(tag string) *pb.Generator {
+		return &pb.Generator{Apply: &pb.Generator_Tag{Tag: tag}}
+	}
+
+	return []SmartDefault{
+		// people
+		{Suffixes: []string{"first_name", "given_name"}, Kinds: str, Generator: tag("{firstname}")},
+		{Suffixes: []string{"middle_name"}, Kinds: str, Generator: tag("{middlename}")},
+		{Suffixes: []string{"last_name", "family_name", "surname"}, Kinds: str, Generator: tag("{lastname}")},
+		{Names: []string{"name", "full_name", "display_name"}, Kinds: str, Generator: tag("{name}")},
+		{Suffixes: []string{"username", "user_name"}, Kinds: str, Generator: tag("{username}")},
+		{Suffixes: []string{"password"}, Kinds: str, Generator: tag("{password:true,true,true,true,false,16}")},
+		{Names: []string{"gender"}, Kinds: str, Generator: tag("{gender}")},
+		{Suffixes: []string{"job_title"}, Kinds: str, Generator: tag("{jobtitle}")},
+		{Suffixes: []string{"company", "company_name", "organization"}, Kinds: str, Generator: tag("{company}")},
+		{Names: []string{"age"}, Kinds: ints, Generator: tag("{number:18,90}")},
+
+		// contact
+		{Suffixes: []string{"email", "email_address"}, Kinds: str, Generator: tag("{email}")},
+		{Suffixes: []string{"phone", "phone_number", "mobile", "telephone"}, Kinds: str, Generator: tag("{phone}")},
+
+		// address
+		{Suffixes: []string{"street", "street_address", "address_line1", "address_line_1"}, Kinds: str, Generator: tag("{street}")},
+		{Suffixes: []string{"city"}, Kinds: str, Generator: tag("{city}")},
+		{Suffixes: []string{"province"}, Kinds: str, Generator: tag("{state}")},
+		{Suffixes: []string{"country_code", "region_code"}, Kinds: str, Generator: tag("{countryabr}")},
+		{Suffixes: []string{"country"}, Kinds: str, Generator: tag("{country}")},
+		{Suffixes: []string{"zip", "zip_code", "postal_code", "postcode"}, Kinds: str, Generator: tag("{zip}")},
+		{Suffixes: []string{"latitude"}, Names: []string{"lat"}, Kinds: floats, Generator: tag("{latitude}")},
+		{Suffixes: []string{"longitude"}, Names: []string{"lng", "lon"}, Kinds: floats, Generator: tag("{longitude}")},
+
+		// internet
+		{Suffixes: []string{"image_url", "avatar_url", "photo_url"}, Kinds: str, Generator: tag("{imageurl:640,480}")},
+		{Suffixes: []string{"url", "uri", "website", "homepage"}, Kinds: str, Generator: tag("{url}")},
+		{Suffixes: []string{"domain", "domain_name", "hostname"}, Kinds: str, Generator: tag("{domainname}")},
+		{Suffixes: []string{"uuid", "guid"}, Kinds: str, Generator: tag("{uuid}")},
+		{Suffixes: []string{"ip", "ip_address", "ipv4", "ipv4_address"}, Kinds: str, Generator: tag("{ipv4address}")},
+		{Suffixes: []string{"ipv6", "ipv6_address"}, Kinds: str, Generator: tag("{ipv6address}")},
+		{Suffixes: []string{"mac_address"}, Kinds: str, Generator: tag("{macaddress}")},
+		{Suffixes: []string{"user_agent"}, Kinds: str, Generator: tag("{useragent}")},
+		{Suffixes: []string{"port"}, Kinds: ints, Generator: tag("{number:1024,65535}")},
+
+		// misc
+		{Suffixes: []string{"currency", "currency_code"}, Kinds: str, Generator: tag("{currencyshort}")},
+		{Suffixes: []string{"language", "language_code"}, Kinds: str, Generator: tag("{languageabbreviation}")},
+		{Suffixes: []string{"timezone", "time_zone"}, Kinds: str, Generator: tag("{timezoneregion}")},
+		{Suffixes: []string{"color", "colour"}, Kinds: str, Generator: tag("{color}")},
+		{Suffixes: []string{"description", "summary", "bio"}, Kinds: str, Generator: tag("{sentence:10}")},
+		{Suffixes: []string{"price", "amount", "cost"}, Kinds: floats, Generator: tag("{price:1,1000}")},
+		{Suffixes: []string{"year"}, Kinds: ints, Generator: tag("{number:1970,2030}")},
+
+		// timestamps, formatted per WithTimestampFormat
+		{
+			Suffixes:  []string{"created_at", "create_time", "updated_at", "update_time", "deleted_at", "delete_time"},
+			Message:   wktTimestampFQN,
+			Generator: dateRange("2015-01-01", "2025-01-01", time.RFC3339Nano),
+			dates:     [2]string{"2015-01-01", "2025-01-01"},
+		},
+		{
+			Suffixes:  []string{"birthday", "birth_date", "date_of_birth", "dob"},
+			Message:   wktTimestampFQN,
+			Generator: dateRange("1940-01-01", "2005-01-01", time.RFC3339Nano),
+			dates:     [2]string{"1940-01-01", "2005-01-01"},
+		},
+	}
+}
+
+// dateRange returns a Generator of timestamps between the dates start and end,
+// formatted per layout.
+func dateRange(start, end, layout string) *pb.Generator {
+	return &pb.Generator{Apply: &pb.Generator_Template{Template: fmt.Sprintf(
+		`{{ (DateRange (ToDate %q) (ToDate %q)).Format %q }}`, start, end, layout)}}
+}
+
+// smartDefault is a SmartDefault with its names and suffixes pre-split into
+// words.
+type smartDefault struct {
+	SmartDefault
+
+	names, suffixes [][]string
+}
+
+func newSmartDefault(rule SmartDefault) smartDefault {
+	out := smartDefault{SmartDefault: rule}
+	for _, name := range rule.Names {
+		out.names = append(out.names, nameWords(name))
+	}
+	for _, suffix := range rule.Suffixes {
+		out.suffixes = append(out.suffixes, nameWords(suffix))
+	}
+	return out
+}
+
+func (sd smartDefault) matchesType(desc protoreflect.FieldDescriptor) bool {
+	switch desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return sd.Message != "" && desc.Message().FullName() == sd.Message
+	default:
+		return slices.Contains(sd.Kinds, desc.Kind())
+	}
+}
+
+func (sd smartDefault) matchesName(words []string) bool {
+	for _, name := range sd.names {
+		if slices.Equal(words, name) {
+			return true
+		}
+	}
+	for _, suffix := range sd.suffixes {
+		if len(words) >= len(suffix) && slices.Equal(words[len(words)-len(suffix):], suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// smartDefault returns the generator of the first smart default rule matching
+// desc, or nil if none match.
+func (pf *protoFaker) smartDefault(desc protoreflect.FieldDescriptor) *pb.Generator {
+	if len(pf.smartDefaults) == 0 || desc.IsMap() {
+		return nil
+	}
+
+	candidates := [][]string{nameWords(string(desc.Name()))}
+	if desc.HasJSONName() {
+		candidates = append(candidates, nameWords(desc.JSONName()))
+	}
+	if desc.IsList() {
+		for _, words := range candidates {
+			if singular, ok := singularWords(words); ok {
+				candidates = append(candidates, singular)
+			}
+		}
+	}
+
+	for _, rule := range pf.smartDefaults {
+		if !rule.matchesType(desc) {
+			continue
+		}
+		for _, words := range candidates {
+			if !rule.matchesName(words) {
+				continue
+			}
+			gen := rule.Generator
+			if rule.dates != [2]string{} {
+				gen = dateRange(rule.dates[0], rule.dates[1], pf.timestampFormat)
+			}
+			if desc.IsList() {
+				return &pb.Generator{Apply: &pb.Generator_Repeated{
+					Repeated: &pb.Repeated{Element: gen},
+				}}
+			}
+			return gen
+		}
+	}
+	return nil
+}
+
+// nameWords splits a snake_case or camelCase name into its lowercase words.
+func nameWords(name string) []string {
+	var (
+		words []string
+		word  strings.Builder
+	)
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == '.':
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			flush()
+		}
+		word.WriteRune(unicode.ToLower(r))
+	}
+	flush()
+	return words
+}
+
+// singularWords naively singularizes the last word of a name, returning false
+// if it does not appear to be plural.
+func singularWords(words []string) ([]string, bool) {
+	if len(words) == 0 {
+		return nil, false
+	}
+	last := words[len(words)-1]
+	var singular string
+	switch {
+	case strings.HasSuffix(last, "ies"):
+		singular = strings.TrimSuffix(last, "ies") + "y"
+	case strings.HasSuffix(last, "sses"), strings.HasSuffix(last, "xes"):
+		singular = strings.TrimSuffix(last, "es")
+	case strings.HasSuffix(last, "s") && !strings.HasSuffix(last, "ss"):
+		singular = strings.TrimSuffix(last, "s")
+	default:
+		return nil, false
+	}
+	return append(slices.Clone(words[:len(words)-1]), singular), true
+}
@@ -0,0 +1,110 @@
+package protogofakeit
+
+import (
+	"net"
+	"net/mail"
+	"net/url"
+	"testing"
+	"time"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestWithSmartDefaults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.SmartDefaults{}
+		err := initProtoFaker(t, WithSmartDefaults()).FakeProto(msg)
+		require.NoError(t, err)
+
+		_, err = mail.ParseAddress(msg.GetEmail())
+		require.NoError(t, err, msg.GetEmail())
+		_, err = url.ParseRequestURI(msg.GetWebsiteUrl())
+		require.NoError(t, err, msg.GetWebsiteUrl())
+		assert.NotNil(t, net.ParseIP(msg.GetIpAddress()), msg.GetIpAddress())
+		assert.Len(t, msg.GetRequestUuid(), 36)
+		assert.Len(t, msg.GetCountryCode(), 2)
+		assert.NotEmpty(t, msg.GetFirstName())
+		assert.NotEmpty(t, msg.GetCity())
+		assert.NotEmpty(t, msg.GetPhoneNumber())
+		inRange(t, int(msg.GetAge()), 18, 90)
+		assert.InDelta(t, 0, msg.GetLatitude(), 90)
+
+		created := msg.GetCreatedAt().AsTime()
+		assert.False(t, created.Before(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)), created)
+		assert.False(t, created.After(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), created)
+
+		sliceInDefault(t, msg.GetEmails())
+		for _, email := range msg.GetEmails() {
+			_, err = mail.ParseAddress(email)
+			require.NoError(t, err, email)
+		}
+
+		assert.Equal(t, "static", msg.GetAnnotatedEmail(), "annotations take precedence")
+		_, err = mail.ParseAddress(msg.GetContact())
+		require.NoError(t, err, "json name should be matched")
+	})
+
+	t.Run("timestamp_format", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithSmartDefaults(), WithTimestampFormat("Jan _2 2006 15:04:05"))
+		for range 20 {
+			msg := &test.SmartDefaults{}
+			require.NoError(t, pf.FakeProto(msg))
+			created := msg.GetCreatedAt().AsTime()
+			assert.False(t, created.Before(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)), created)
+			assert.False(t, created.After(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), created)
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.SmartDefaults{}
+		err := initProtoFaker(t, WithSmartDefaults(SmartDefault{
+			Names:     []string{"nickname"},
+			Kinds:     []protoreflect.Kind{protoreflect.StringKind},
+			Generator: &pb.Generator{Apply: &pb.Generator_Tag{Tag: "nick"}},
+		})).FakeProto(msg)
+		require.NoError(t, err)
+		assert.Equal(t, "nick", msg.GetNickname())
+		_, err = mail.ParseAddress(msg.GetEmail())
+		require.Error(t, err, "only the custom rules should apply")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.SmartDefaults{}
+		err := initProtoFaker(t).FakeProto(msg)
+		require.NoError(t, err)
+		_, err = mail.ParseAddress(msg.GetEmail())
+		require.Error(t, err)
+	})
+}
+
+func TestNameWords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ex   []string
+	}{
+		{"email", []string{"email"}},
+		{"work_email", []string{"work", "email"}},
+		{"workEmail", []string{"work", "email"}},
+		{"WorkEmail", []string{"work", "email"}},
+		{"userID", []string{"user", "id"}},
+		{"HTTPStatus", []string{"http", "status"}},
+		{"address_line1", []string{"address", "line1"}},
+		{"__weird__", []string{"weird"}},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.ex, nameWords(tc.name), tc.name)
+	}
+}
@@ -0,0 +1,106 @@
+package protogofakeit
+
+import (
+	"context"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Stream returns an iterator producing an unbounded sequence of messages of
+// type T, each populated with fake data via pf. This permits generating large
+// volumes of messages without holding them in memory:
+//
+//	for user, err := range protogofakeit.Stream[*pb.User](ctx, pf, protogofakeit.WithStreamLimit(1e6)) {
+//		if err != nil {
+//			return err
+//		}
+//		if err = enc.Encode(user); err != nil {
+//			return err
+//		}
+//	}
+//
+// Iteration ends once ctx is done, yielding the context's error, or after an
+// error populating a message is yielded.
+func Stream[T proto.Message](ctx context.Context, pf ProtoFaker, opts ...StreamOption) iter.Seq2[T, error] {
+	return stream(ctx, pf, newMessage[T], opts)
+}
+
+// StreamDescriptor is the same as [Stream], but produces dynamic messages of
+// the type described by desc.
+func StreamDescriptor(
+	ctx context.Context,
+	pf ProtoFaker,
+	desc protoreflect.MessageDescriptor,
+	opts ...StreamOption,
+) iter.Seq2[*dynamicpb.Message, error] {
+	return stream(ctx, pf, func() *dynamicpb.Message { return dynamicpb.NewMessage(desc) }, opts)
+}
+
+// A StreamOption modifies the behavior of [Stream] or [StreamDescriptor].
+type StreamOption interface {
+	applyStream(cfg *streamConfig)
+}
+
+// WithStreamLimit ends the stream after n messages are produced. By default,
+// streams are unbounded.
+func WithStreamLimit(n int) StreamOption {
+	return streamOptionFunc(func(cfg *streamConfig) { cfg.limit = n })
+}
+
+// WithStreamReuse reuses a single message across the stream, resetting it
+// before each iteration to minimize allocations. Yielded messages are only
+// valid until the next iteration and must be cloned (see [proto.Clone]) to be
+// retained. By default, a new message is allocated for each iteration.
+func WithStreamReuse() StreamOption {
+	return streamOptionFunc(func(cfg *streamConfig) { cfg.reuse = true })
+}
+
+type streamConfig struct {
+	limit int
+	reuse bool
+}
+
+func stream[T proto.Message](
+	ctx context.Context,
+	pf ProtoFaker,
+	newMsg func() T,
+	opts []StreamOption,
+) iter.Seq2[T, error] {
+	cfg := streamConfig{limit: -1}
+	for _, opt := range opts {
+		opt.applyStream(&cfg)
+	}
+
+	return func(yield func(T, error) bool) {
+		var msg T
+		if cfg.reuse {
+			msg = newMsg()
+		}
+		for i := 0; cfg.limit < 0 || i < cfg.limit; i++ {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if cfg.reuse {
+				proto.Reset(msg)
+			} else {
+				msg = newMsg()
+			}
+			if err := pf.FakeProto(msg); err != nil {
+				yield(msg, err)
+				return
+			}
+			if !yield(msg, nil) {
+				return
+			}
+		}
+	}
+}
+
+type streamOptionFunc func(cfg *streamConfig)
+
+func (fn streamOptionFunc) applyStream(cfg *streamConfig) { fn(cfg) }
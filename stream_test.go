@@ -0,0 +1,87 @@
+package protogofakeit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limit", func(t *testing.T) {
+		t.Parallel()
+		var msgs []*test.ScalarStaticTags
+		for msg, err := range Stream[*test.ScalarStaticTags](t.Context(), initProtoFaker(t), WithStreamLimit(5)) {
+			require.NoError(t, err)
+			assert.Equal(t, "foobar", msg.GetString_())
+			msgs = append(msgs, msg)
+		}
+		require.Len(t, msgs, 5)
+		assert.NotSame(t, msgs[0], msgs[1])
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		t.Parallel()
+		var prev *test.SelfRecursive
+		count := 0
+		for msg, err := range Stream[*test.SelfRecursive](t.Context(), initProtoFaker(t), WithStreamReuse()) {
+			require.NoError(t, err)
+			if prev != nil {
+				assert.Same(t, prev, msg)
+			}
+			prev = msg
+			if count++; count == 3 {
+				break
+			}
+		}
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		count := 0
+		var lastErr error
+		for _, err := range Stream[*test.ScalarDefaults](ctx, initProtoFaker(t)) {
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if count++; count == 3 {
+				cancel()
+			}
+		}
+		assert.Equal(t, 3, count)
+		require.ErrorIs(t, lastErr, context.Canceled)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		count := 0
+		for _, err := range Stream[*test.CustomTemplate](t.Context(), initProtoFaker(t)) {
+			require.Error(t, err)
+			count++
+		}
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestStreamDescriptor(t *testing.T) {
+	t.Parallel()
+
+	desc := (&test.ScalarStaticTags{}).ProtoReflect().Descriptor()
+	count := 0
+	for msg, err := range StreamDescriptor(t.Context(), initProtoFaker(t), desc, WithStreamLimit(3)) {
+		require.NoError(t, err)
+		assert.IsType(t, &dynamicpb.Message{}, msg)
+		assert.Equal(t, "foobar", msg.Get(desc.Fields().ByName("string")).String())
+		count++
+	}
+	assert.Equal(t, 3, count)
+}
@@ -0,0 +1,247 @@
+package protogofakeit
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brianvoe/gofakeit/v6"
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WithStrings sets the characters and length of generated strings, in place
+// of ASCII letters with lengths from [WithStringSize]. It applies to all string
+// fields without a tag or template, and is overridden by the gofakeit.strings
+// option of a message or the strings generator of a field. Invalid options
+// (such as an unknown script name) are returned from every call to
+// [ProtoFaker.FakeProto].
+func WithStrings(opts *pb.Strings) Option {
+	return optionFunc(func(pf *protoFaker) {
+		if _, err := compileStrings(opts, pf.stringSize); err != nil {
+			pf.addErr(fmt.Errorf("invalid strings options: %w", err))
+			return
+		}
+		pf.strings = opts
+	})
+}
+
+// maxCharAttempts bounds the number of random draws made to find a character
+// matching a charset, or to find one that fits within a length in bytes.
+const maxCharAttempts = 100
+
+// maxBMPRune is the highest code point of the Basic Multilingual Plane.
+const maxBMPRune = 0xFFFF
+
+// stringPlan is compiled Strings options, generating strings one grapheme
+// cluster at a time.
+type stringPlan struct {
+	unit   pb.LengthUnit
+	length size
+	// letters is set for the default charset of ASCII letters, generated the
+	// same as gofakeit.Faker.Generate with '?' placeholders.
+	letters bool
+	cluster func(faker *gofakeit.Faker) string
+}
+
+// stringsFor resolves the Strings options of the field desc from the
+// gofakeit.strings option of its message, falling back to WithStrings.
+func (pf *protoFaker) stringsFor(desc protoreflect.FieldDescriptor) *pb.Strings {
+	if msg := desc.ContainingMessage(); msg != nil {
+		if strs, _ := proto.GetExtension(msg.Options(), pb.E_Strings).(*pb.Strings); strs != nil {
+			return strs
+		}
+	}
+	return pf.strings
+}
+
+func compileStrings(src *pb.Strings, def size) (*stringPlan, error) {
+	out := &stringPlan{unit: src.GetUnit(), length: def}
+	if rng := src.GetLength(); rng != nil {
+		if rng.GetMin() > rng.GetMax() {
+			return nil, fmt.Errorf("length minimum %d is greater than maximum %d", rng.GetMin(), rng.GetMax())
+		}
+		out.length = size{min: int(rng.GetMin()), max: int(rng.GetMax())}
+	}
+
+	switch src.GetCharset() {
+	case pb.Charset_CHARSET_UNSPECIFIED, pb.Charset_CHARSET_ASCII_LETTERS:
+		out.letters = true
+	case pb.Charset_CHARSET_ALPHANUMERIC:
+		out.cluster = asciiCluster("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	case pb.Charset_CHARSET_PRINTABLE:
+		var printable strings.Builder
+		for c := byte(' '); c <= '~'; c++ {
+			printable.WriteByte(c)
+		}
+		out.cluster = asciiCluster(printable.String())
+	case pb.Charset_CHARSET_BMP:
+		jamo := conjoiningJamo()
+		out.cluster = runeCluster(func(faker *gofakeit.Faker) rune {
+			return rune(faker.Rand.Intn(maxBMPRune + 1))
+		}, func(r rune) bool {
+			// Marks and conjoining Hangul jamo would otherwise join with the
+			// preceding character into a single grapheme cluster.
+			return unicode.IsGraphic(r) && !unicode.Is(unicode.M, r) && !unicode.Is(jamo, r)
+		})
+	case pb.Charset_CHARSET_EMOJI:
+		out.cluster = runeCluster(tableRune(emojiTable()), func(r rune) bool {
+			return unicode.Is(unicode.So, r)
+		})
+	case pb.Charset_CHARSET_RTL:
+		out.cluster = runeCluster(tableRune(unicode.Hebrew, unicode.Arabic), unicode.IsLetter)
+	case pb.Charset_CHARSET_COMBINING:
+		out.cluster = combiningCluster
+	case pb.Charset_CHARSET_SCRIPTS:
+		if len(src.GetScripts()) == 0 {
+			return nil, fmt.Errorf("no scripts named for %s", src.GetCharset())
+		}
+		tables := make([]*unicode.RangeTable, 0, len(src.GetScripts()))
+		for _, name := range src.GetScripts() {
+			table, ok := unicode.Scripts[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown script %q", name)
+			}
+			tables = append(tables, table)
+		}
+		out.cluster = runeCluster(tableRune(tables...), unicode.IsLetter)
+	default:
+		return nil, fmt.Errorf("unknown charset %s", src.GetCharset())
+	}
+	return out, nil
+}
+
+func (p *stringPlan) generate(faker *gofakeit.Faker) string {
+	return p.generateLen(faker, p.length.Fake(faker))
+}
+
+// generateLen generates a string of length n, measured in the unit of p.
+func (p *stringPlan) generateLen(faker *gofakeit.Faker, n int) string {
+	if p.letters {
+		return faker.Generate(strings.Repeat("?", n))
+	}
+
+	var out strings.Builder
+	switch p.unit {
+	case pb.LengthUnit_LENGTH_UNIT_BYTES:
+		for misses := 0; out.Len() < n && misses < maxCharAttempts; {
+			if cluster := p.cluster(faker); out.Len()+len(cluster) <= n {
+				out.WriteString(cluster)
+			} else {
+				misses++
+			}
+		}
+	case pb.LengthUnit_LENGTH_UNIT_GRAPHEMES:
+		for range n {
+			out.WriteString(p.cluster(faker))
+		}
+	case pb.LengthUnit_LENGTH_UNIT_UNSPECIFIED, pb.LengthUnit_LENGTH_UNIT_RUNES:
+		fallthrough
+	default:
+		for runes := 0; runes < n; {
+			cluster := p.cluster(faker)
+			for _, r := range cluster {
+				if runes == n {
+					break
+				}
+				out.WriteRune(r)
+				runes++
+			}
+		}
+	}
+	return out.String()
+}
+
+func asciiCluster(chars string) func(*gofakeit.Faker) string {
+	return func(faker *gofakeit.Faker) string {
+		i := faker.Rand.Intn(len(chars))
+		return chars[i : i+1]
+	}
+}
+
+// runeCluster returns a generator of single runes drawn by next, redrawn
+// until one satisfies accept.
+func runeCluster(next func(*gofakeit.Faker) rune, accept func(rune) bool) func(*gofakeit.Faker) string {
+	return func(faker *gofakeit.Faker) string {
+		r := next(faker)
+		for range maxCharAttempts {
+			if utf8.ValidRune(r) && accept(r) {
+				break
+			}
+			r = next(faker)
+		}
+		if !utf8.ValidRune(r) || !accept(r) {
+			r = unicode.ReplacementChar
+		}
+		return string(r)
+	}
+}
+
+// combiningCluster is an ASCII letter followed by one to three combining
+// diacritical marks (U+0300 to U+036F).
+func combiningCluster(faker *gofakeit.Faker) string {
+	const firstMark, numMarks = 0x0300, 0x70
+	var out strings.Builder
+	out.WriteString(faker.Letter())
+	for range faker.Number(1, 3) {
+		out.WriteRune(rune(firstMark + faker.Rand.Intn(numMarks)))
+	}
+	return out.String()
+}
+
+// tableRune returns a function drawing uniformly from the runes of tables.
+func tableRune(tables ...*unicode.RangeTable) func(*gofakeit.Faker) rune {
+	type span struct {
+		lo, stride, count int
+	}
+	var spans []span
+	total := 0
+	add := func(lo, hi, stride int) {
+		count := (hi-lo)/stride + 1
+		spans = append(spans, span{lo: lo, stride: stride, count: count})
+		total += count
+	}
+	for _, table := range tables {
+		for _, r := range table.R16 {
+			add(int(r.Lo), int(r.Hi), int(r.Stride))
+		}
+		for _, r := range table.R32 {
+			add(int(r.Lo), int(r.Hi), int(r.Stride))
+		}
+	}
+	return func(faker *gofakeit.Faker) rune {
+		i := faker.Rand.Intn(total)
+		for _, s := range spans {
+			if i < s.count {
+				return rune(s.lo + i*s.stride)
+			}
+			i -= s.count
+		}
+		return unicode.ReplacementChar
+	}
+}
+
+// conjoiningJamo covers the Hangul jamo that combine into syllables.
+func conjoiningJamo() *unicode.RangeTable {
+	return &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1100, Hi: 0x11FF, Stride: 1}, // Hangul Jamo
+			{Lo: 0xA960, Hi: 0xA97F, Stride: 1}, // Hangul Jamo Extended-A
+			{Lo: 0xD7B0, Hi: 0xD7FF, Stride: 1}, // Hangul Jamo Extended-B
+		},
+	}
+}
+
+// emojiTable covers the blocks of pictographic emoji.
+func emojiTable() *unicode.RangeTable {
+	return &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{Lo: 0x1F300, Hi: 0x1F5FF, Stride: 1}, // Miscellaneous Symbols and Pictographs
+			{Lo: 0x1F600, Hi: 0x1F64F, Stride: 1}, // Emoticons
+			{Lo: 0x1F680, Hi: 0x1F6FF, Stride: 1}, // Transport and Map Symbols
+			{Lo: 0x1F900, Hi: 0x1F9FF, Stride: 1}, // Supplemental Symbols and Pictographs
+		},
+	}
+}
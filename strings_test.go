@@ -0,0 +1,98 @@
+package protogofakeit
+
+import (
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithStrings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("field", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t)
+		for range 20 {
+			msg := &test.StringsField{}
+			require.NoError(t, pf.FakeProto(msg))
+
+			assert.True(t, utf8.ValidString(msg.GetBmp()))
+			assert.Equal(t, 5, utf8.RuneCountInString(msg.GetBmp()))
+			for _, r := range msg.GetBmp() {
+				assert.LessOrEqual(t, r, rune(maxBMPRune))
+			}
+
+			assert.Equal(t, 3, utf8.RuneCountInString(msg.GetEmoji()))
+			for _, r := range msg.GetEmoji() {
+				assert.True(t, unicode.Is(unicode.So, r), "%U", r)
+			}
+
+			assert.LessOrEqual(t, len(msg.GetRtl()), 7)
+			assert.GreaterOrEqual(t, len(msg.GetRtl()), 6)
+			for _, r := range msg.GetRtl() {
+				assert.True(t, unicode.In(r, unicode.Hebrew, unicode.Arabic), "%U", r)
+			}
+
+			letters := 0
+			for _, r := range msg.GetCombining() {
+				if unicode.IsLetter(r) {
+					letters++
+				} else {
+					assert.True(t, unicode.Is(unicode.Mn, r), "%U", r)
+				}
+			}
+			assert.Equal(t, 2, letters)
+
+			assert.Equal(t, 4, utf8.RuneCountInString(msg.GetGreek()))
+			for _, r := range msg.GetGreek() {
+				assert.True(t, unicode.Is(unicode.Greek, r), "%U", r)
+			}
+
+			for _, s := range msg.GetAlphanumeric() {
+				assert.Regexp(t, `^[a-zA-Z0-9]{6}$`, s)
+			}
+			assert.Regexp(t, `^[a-zA-Z]{4,10}$`, msg.GetPlain())
+		}
+	})
+
+	t.Run("message", func(t *testing.T) {
+		t.Parallel()
+		msg := &test.StringsMessage{}
+		require.NoError(t, initProtoFaker(t).FakeProto(msg))
+		assert.Regexp(t, `^[ -~]{8}$`, msg.GetValue())
+		for key, val := range msg.GetLabels() {
+			assert.Regexp(t, `^[ -~]{8}$`, key)
+			assert.Regexp(t, `^[ -~]{8}$`, val)
+		}
+		assert.Regexp(t, `^[a-zA-Z0-9]{4,10}$`, msg.GetField())
+		assert.Equal(t, "fixed", msg.GetTag())
+	})
+
+	t.Run("global", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithStrings(&pb.Strings{
+			Charset: pb.Charset_CHARSET_ALPHANUMERIC,
+			Length:  &pb.Range{Min: 12, Max: 12},
+		}))
+
+		field := &test.StringsField{}
+		require.NoError(t, pf.FakeProto(field))
+		assert.Regexp(t, `^[a-zA-Z0-9]{12}$`, field.GetPlain())
+		assert.Equal(t, 4, utf8.RuneCountInString(field.GetGreek()))
+
+		msg := &test.StringsMessage{}
+		require.NoError(t, pf.FakeProto(msg))
+		assert.Regexp(t, `^[ -~]{8}$`, msg.GetValue())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		pf := initProtoFaker(t, WithStrings(&pb.Strings{Charset: pb.Charset_CHARSET_SCRIPTS}))
+		require.ErrorContains(t, pf.FakeProto(&test.StringsField{}), "no scripts named")
+	})
+}
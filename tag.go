@@ -0,0 +1,72 @@
+package protogofakeit
+
+import (
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// compiledTag is a gofakeit tag scanned ahead of time for its function
+// lookups. Values are generated by gofakeit.Faker.Generate, which rescans the
+// tag after each replacement (so a replacement may itself form a lookup), and
+// only tags producing the same value every time skip it.
+type compiledTag struct {
+	raw string
+	// random is set for tags with function lookups or random replacements
+	// ('#', '?', or a leading '0').
+	random bool
+	// unknown lists the names within braces that are not function lookups,
+	// excluding any within the parameters of a known function.
+	unknown []string
+}
+
+// constant reports whether the tag produces the same value every time.
+func (t *compiledTag) constant() bool {
+	return !t.random
+}
+
+func compileTag(tag string) *compiledTag {
+	out := &compiledTag{
+		raw:    tag,
+		random: strings.ContainsAny(tag, "#?") || strings.HasPrefix(tag, "0"),
+	}
+
+	// Mirrors the scanning of gofakeit's generate: the innermost braces
+	// preceding a closing brace name a function. If the name is unknown, the
+	// braces are literal and the enclosing open brace is considered instead.
+	type unknownName struct {
+		start int
+		name  string
+	}
+	var opens []int
+	var unknown []unknownName
+	for i := range len(tag) {
+		switch {
+		case tag[i] == '{':
+			opens = append(opens, i)
+		case tag[i] == '}' && len(opens) > 0:
+			start := opens[len(opens)-1]
+			opens = opens[:len(opens)-1]
+			name, _, _ := strings.Cut(tag[start+1:i], ":")
+			if gofakeit.GetFuncLookup(name) == nil {
+				unknown = append(unknown, unknownName{start: start, name: name})
+				continue
+			}
+			for len(unknown) > 0 && unknown[len(unknown)-1].start > start {
+				unknown = unknown[:len(unknown)-1]
+			}
+			out.random, opens = true, opens[:0]
+		}
+	}
+	for _, name := range unknown {
+		out.unknown = append(out.unknown, name.name)
+	}
+	return out
+}
+
+func (t *compiledTag) generate(faker *gofakeit.Faker) string {
+	if !t.random {
+		return t.raw
+	}
+	return faker.Generate(t.raw)
+}
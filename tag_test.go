@@ -0,0 +1,62 @@
+package protogofakeit
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileTag(t *testing.T) {
+	t.Parallel()
+
+	tags := []string{
+		"plain",
+		"{firstname}",
+		"Hello, {firstname} {lastname}!",
+		"{number:1,10}",
+		"{price:1.5, 10}",
+		"{randomstring:[foo,bar,baz]}",
+		"{randomint:[1,2,3]}",
+		"{regex:[a-z]{3}}",
+		"{sentence:5}",
+		"{uuid}-{email}",
+		"{unknown}",
+		"{unknown}{firstname}",
+		"{{firstname}}",
+		"{number:1,{x}}",
+		"{ {",
+		"} }",
+		"0{digit}",
+		"###-???",
+		"{number:#,9}",
+		"{{randomstring:[firstname]}}",
+		"{randomstring:[{,}]}firstname}",
+	}
+
+	for _, tag := range tags {
+		compiled := compileTag(tag)
+		for seed := int64(1); seed <= 10; seed++ {
+			expected := gofakeit.NewUnlocked(seed).Generate(tag)
+			assert.Equal(t, expected, compiled.generate(gofakeit.NewUnlocked(seed)), "tag=%q seed=%d", tag, seed)
+		}
+	}
+}
+
+func TestCompileTagRescan(t *testing.T) {
+	t.Parallel()
+
+	// gofakeit rescans the tag after each replacement, so "{firstname}" is
+	// itself replaced.
+	compiled := compileTag("{{randomstring:[firstname]}}")
+	assert.False(t, compiled.constant())
+	assert.Empty(t, compiled.unknown)
+	out := compiled.generate(gofakeit.NewUnlocked(1))
+	assert.NotContains(t, out, "{")
+	assert.NotContains(t, out, "firstname")
+
+	compiled = compileTag("{unknown} {{x}}")
+	assert.True(t, compiled.constant())
+	assert.Equal(t, []string{"unknown", "x", "{x}"}, compiled.unknown)
+	assert.Equal(t, "{unknown} {{x}}", compiled.generate(gofakeit.NewUnlocked(1)))
+}
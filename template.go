@@ -0,0 +1,182 @@
+package protogofakeit
+
+import (
+	"bytes"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// compiledTemplate is a gofakeit template parsed ahead of time, producing the
+// same output as gofakeit.Faker.Template without re-parsing the template on
+// each call. gofakeit's Template API is not used as it parses the template on
+// every call and executes it against the TemplateOptions, leaving no room for
+// templateData.
+//
+// The functions available to a template are bound to a faker when it is
+// parsed, and rebinding them requires cloning the template. Instead, each
+// parsed copy is bound to its own faker, whose random source is replaced with
+// that of the caller for the duration of an execution. Copies are pooled, so
+// each is used by one execution at a time.
+type compiledTemplate struct {
+	parsed *template.Template
+	opts   *gofakeit.TemplateOptions
+	pool   sync.Pool
+	// refs are the paths of the fields referenced by the template.
+	refs []string
+}
+
+type boundTemplate struct {
+	tpl   *template.Template
+	faker *gofakeit.Faker
+}
+
+func compileTemplate(text string, opts *gofakeit.TemplateOptions) (*compiledTemplate, error) {
+	faker := &gofakeit.Faker{}
+	parsed, err := template.New("CodeRun").Funcs(templateFuncs(faker, opts)).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	out := &compiledTemplate{parsed: parsed, opts: opts}
+	for _, tpl := range parsed.Templates() {
+		out.refs = append(out.refs, templateReferences(tpl.Tree)...)
+	}
+	out.pool.New = func() any {
+		faker := &gofakeit.Faker{}
+		tpl := template.Must(out.parsed.Clone())
+		return &boundTemplate{tpl: tpl.Funcs(templateFuncs(faker, opts)), faker: faker}
+	}
+	out.pool.Put(&boundTemplate{tpl: parsed, faker: faker})
+	return out, nil
+}
+
+// execute runs the template with the random source of faker. Fields of msg,
+// which may be nil, are accessible to the template (see templateData) through
+// refs, the compiled references of its plan.
+func (t *compiledTemplate) execute(
+	faker *gofakeit.Faker,
+	msg protoreflect.Message,
+	refs map[string]*reference,
+) (string, error) {
+	bound, _ := t.pool.Get().(*boundTemplate)
+	bound.faker.Rand = faker.Rand
+	defer func() {
+		bound.faker.Rand = nil
+		t.pool.Put(bound)
+	}()
+
+	data := &templateData{TemplateOptions: t.opts, msg: msg, refs: refs}
+	if data.TemplateOptions == nil {
+		data.TemplateOptions = &gofakeit.TemplateOptions{}
+	}
+	var buf bytes.Buffer
+	if err := bound.tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(buf.String(), `\n`, "\n"), nil
+}
+
+// templateFuncs mirrors the functions gofakeit makes available to templates,
+// bound to faker, along with any custom functions in opts. gofakeit does not
+// export its function map, so TestTemplateFuncs compares the two to catch any
+// changes to gofakeit's.
+//
+//nolint:cyclop,funlen
+func templateFuncs(faker *gofakeit.Faker, opts *gofakeit.TemplateOptions) template.FuncMap {
+	funcs := template.FuncMap{}
+	val := reflect.ValueOf(faker)
+	typ := val.Type()
+	for i := range typ.NumMethod() {
+		method := typ.Method(i)
+		if slices.Contains([]string{"RandomMapKey", "SQL", "Template"}, method.Name) ||
+			method.Type.NumOut() == 0 {
+			continue
+		}
+		funcs[method.Name] = val.Method(i).Interface()
+	}
+
+	funcs["ToUpper"] = strings.ToUpper
+	funcs["ToLower"] = strings.ToLower
+	funcs["IntRange"] = func(start, end int) []int {
+		out := make([]int, end-start+1)
+		for i := range out {
+			out[i] = start + i
+		}
+		return out
+	}
+	funcs["ToInt"] = func(arg any) int {
+		switch v := arg.(type) {
+		case string:
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return 0
+			}
+			return i
+		case float64:
+			return int(v)
+		case float32:
+			return int(v)
+		case int:
+			return v
+		default:
+			return 0
+		}
+	}
+	funcs["ToFloat"] = func(arg any) float64 {
+		switch v := arg.(type) {
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return 0
+			}
+			return f
+		case float64:
+			return v
+		case float32:
+			return float64(v)
+		case int:
+			return float64(v)
+		default:
+			return 0
+		}
+	}
+	funcs["ToString"] = func(arg any) string {
+		switch v := arg.(type) {
+		case string:
+			return v
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case float32:
+			return strconv.FormatFloat(float64(v), 'f', -1, 32)
+		case int:
+			return strconv.Itoa(v)
+		default:
+			return ""
+		}
+	}
+	funcs["ToDate"] = func(date string) time.Time {
+		t, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return time.Now()
+		}
+		return t
+	}
+	funcs["SliceAny"] = func(args ...any) []any { return args }
+	funcs["SliceString"] = func(args ...string) []string { return args }
+	funcs["SliceUInt"] = func(args ...uint) []uint { return args }
+	funcs["SliceInt"] = func(args ...int) []int { return args }
+	funcs["SliceF32"] = func(args ...float32) []float32 { return args }
+
+	if opts != nil {
+		maps.Copy(funcs, opts.Funcs)
+	}
+	return funcs
+}
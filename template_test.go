@@ -0,0 +1,100 @@
+package protogofakeit
+
+import (
+	"maps"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"text/template"
+	_ "unsafe" // for go:linkname
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileTemplate(t *testing.T) {
+	t.Parallel()
+
+	opts := &gofakeit.TemplateOptions{
+		Funcs: map[string]any{"Shout": func(s string) string { return s + "!" }},
+		Data:  map[string]string{"Greeting": "Hello"},
+	}
+
+	tests := []struct {
+		text string
+		opts *gofakeit.TemplateOptions
+	}{
+		{text: "plain"},
+		{text: "{{FirstName}} {{LastName}}"},
+		{text: `{{Number 1 10}}\n{{RandomString (SliceString "a" "b" "c")}}`},
+		{text: "{{range IntRange 1 3}}{{Digit}}{{end}}"},
+		{text: "{{ToUpper (Word)}} {{ToInt \"42\"}} {{ToString 1.5}}"},
+		{text: "{{UUID}} {{Sentence 5}}"},
+		{text: "{{.Data.Greeting}}, {{Shout FirstName}}", opts: opts},
+	}
+
+	for _, tc := range tests {
+		compiled, err := compileTemplate(tc.text, tc.opts)
+		require.NoError(t, err)
+		for seed := int64(1); seed <= 10; seed++ {
+			expected, err := gofakeit.NewUnlocked(seed).Template(tc.text, tc.opts)
+			require.NoError(t, err)
+			actual, err := compiled.execute(gofakeit.NewUnlocked(seed), nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual, "template=%q seed=%d", tc.text, seed)
+		}
+	}
+
+	_, err := compileTemplate("{{Unknown}}", nil)
+	require.Error(t, err)
+
+	compiled, err := compileTemplate("{{Number 1 10}}", nil)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			faker := gofakeit.NewUnlocked(1)
+			expected := gofakeit.NewUnlocked(1)
+			for range 20 {
+				out, err := compiled.execute(faker, nil, nil)
+				assert.NoError(t, err)
+				exp, _ := expected.Template("{{Number 1 10}}", nil)
+				assert.Equal(t, exp, out)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+//go:linkname gofakeitTemplateFuncs github.com/brianvoe/gofakeit/v6.templateFuncMap
+func gofakeitTemplateFuncs(r *rand.Rand, fm *template.FuncMap) *template.FuncMap
+
+// TestTemplateFuncs fails when gofakeit changes the functions available to its
+// templates, which templateFuncs must then be updated to match.
+func TestTemplateFuncs(t *testing.T) {
+	t.Parallel()
+
+	expected := *gofakeitTemplateFuncs(rand.New(rand.NewSource(1)), nil)
+	actual := templateFuncs(gofakeit.NewUnlocked(1), nil)
+	require.ElementsMatch(t, slices.Collect(maps.Keys(expected)), slices.Collect(maps.Keys(actual)))
+	for name, fn := range expected {
+		assert.Equal(t, reflect.TypeOf(fn), reflect.TypeOf(actual[name]), name)
+	}
+}
+
+func TestCompileTemplateError(t *testing.T) {
+	t.Parallel()
+
+	desc := (&test.CustomTemplate{}).ProtoReflect().Descriptor()
+	err := Compile(initProtoFaker(t), desc)
+	require.ErrorContains(t, err, "gofakeit.test.CustomTemplate.value")
+
+	tplOpts := &gofakeit.TemplateOptions{
+		Funcs: map[string]any{"custom_func": func() string { return "foo" }},
+	}
+	require.NoError(t, Compile(initProtoFaker(t, WithTemplateOptions(tplOpts)), desc))
+}
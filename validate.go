@@ -0,0 +1,277 @@
+package protogofakeit
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// An Issue describes a misconfigured generator found by [Validate].
+type Issue struct {
+	// Field is the field whose generator is misconfigured. It is nil for
+	// issues with the options passed to Validate or with the descriptor
+	// itself.
+	Field protoreflect.FieldDescriptor
+	// Message describes the problem with the generator.
+	Message string
+}
+
+// String returns a description of the issue, prefixed by the full name of the
+// field, if any.
+func (issue Issue) String() string {
+	if issue.Field == nil {
+		return issue.Message
+	}
+	return fmt.Sprintf("%s: %s", issue.Field.FullName(), issue.Message)
+}
+
+// Validate statically checks the generator of every field declared within
+// desc, which must be a protoreflect.FileDescriptor or
+// protoreflect.MessageDescriptor (including any nested messages). Generators
+// are resolved from annotations and from any overlays or rules configured by
+// opts, which also supply the template options and timestamp format used. The
+// following are reported:
+//
+//   - range sizes with a minimum greater than the maximum
+//   - tags or templates on message fields, where they are ignored
+//   - repeated options on non-repeated fields and map options on non-maps
+//   - templates that fail to parse
+//   - tags referencing unknown functions
+//   - constant tags that do not parse as the field's type
+//   - strings options on non-string fields or naming unknown scripts
+//   - invalid patterns, or patterns on fields other than strings and bytes
+//   - bytes options on non-bytes fields, or constant tags they cannot decode
+//   - embedded options on fields other than strings and bytes, naming unknown
+//     message types, or binary messages on string fields without base64
+//   - references to unknown fields, copy_from between fields of differing
+//     types, and reference cycles
+//   - copy_from or cel on list elements or map entries
+//   - invalid cel expressions, or cel on map fields
+//
+// Validate is intended to be called from unit tests, catching
+// misconfigurations that may otherwise only surface when a particular field
+// happens to be generated. Errors in opts themselves, such as overlays naming
+// unknown fields or rules with invalid patterns, are reported as issues without
+// a field, as are descriptors of any other kind.
+func Validate(desc protoreflect.Descriptor, opts ...Option) []Issue {
+	pf, _ := New(nil, opts...).(*protoFaker)
+	var issues []Issue
+	for _, err := range flattenErrors(pf.err) {
+		issues = append(issues, Issue{Message: err.Error()})
+	}
+	var validateMessages func(msgs protoreflect.MessageDescriptors)
+	validateMessage := func(msg protoreflect.MessageDescriptor) {
+		fields := msg.Fields()
+		for i, n := 0, fields.Len(); i < n; i++ {
+			fdesc := fields.Get(i)
+			v := validator{pf: pf, field: fdesc}
+			v.validate(fdesc, pf.generator(fdesc), true)
+			issues = append(issues, v.issues...)
+		}
+		_, refIssues := orderFields(msg, pf.plan(msg))
+		issues = append(issues, refIssues...)
+		validateMessages(msg.Messages())
+	}
+	validateMessages = func(msgs protoreflect.MessageDescriptors) {
+		for i, n := 0, msgs.Len(); i < n; i++ {
+			if msg := msgs.Get(i); !msg.IsMapEntry() {
+				validateMessage(msg)
+			}
+		}
+	}
+
+	switch desc := desc.(type) {
+	case protoreflect.FileDescriptor:
+		validateMessages(desc.Messages())
+	case protoreflect.MessageDescriptor:
+		validateMessage(desc)
+	default:
+		issues = append(issues, Issue{
+			Message: fmt.Sprintf("unsupported descriptor %T: must be a file or message descriptor", desc),
+		})
+	}
+	return issues
+}
+
+// flattenErrors returns the errors joined within err, recursively.
+func flattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+	var out []error
+	for _, inner := range joined.Unwrap() {
+		out = append(out, flattenErrors(inner)...)
+	}
+	return out
+}
+
+type validator struct {
+	pf     *protoFaker
+	field  protoreflect.FieldDescriptor
+	issues []Issue
+}
+
+func (v *validator) addf(format string, args ...any) {
+	v.issues = append(v.issues, Issue{
+		Field:   v.field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// validate checks gen, applied to values of target. If whole is true, gen
+// applies to the entire field rather than to list elements or map keys or
+// values.
+func (v *validator) validate(target protoreflect.FieldDescriptor, gen *pb.Generator, whole bool) {
+	if gen.GetCopyFrom() != "" {
+		if !whole {
+			v.addf("copy_from on list elements or map entries")
+		}
+		return
+	}
+	if expr := gen.GetCel(); expr != "" {
+		if !whole {
+			v.addf("cel on list elements or map entries")
+			return
+		}
+		env := func() (*cel.Env, error) { return newCELEnv(v.field.ContainingMessage()) }
+		if err := compileCELField(env, v.field, &generatorPlan{Generator: gen}); err != nil {
+			v.addf("%v", err)
+		}
+		return
+	}
+	if rep := gen.GetRepeated(); rep != nil {
+		if !whole || !v.field.IsList() {
+			v.addf("repeated options on a non-repeated field")
+			return
+		}
+		v.validateRange(rep.GetRange())
+		if el := rep.GetElement(); el != nil {
+			v.validate(target, el, false)
+		}
+		return
+	}
+	if mapGen := gen.GetMap(); mapGen != nil {
+		if !whole || !v.field.IsMap() {
+			v.addf("map options on a non-map field")
+			return
+		}
+		v.validateRange(mapGen.GetRange())
+		if key := mapGen.GetKey(); key != nil {
+			v.validate(target.MapKey(), key, false)
+		}
+		if val := mapGen.GetValue(); val != nil {
+			v.validate(target.MapValue(), val, false)
+		}
+		return
+	}
+	if whole && target.IsMap() {
+		target = target.MapValue()
+	}
+	if strs := gen.GetStrings(); strs != nil {
+		if target.Kind() != protoreflect.StringKind {
+			v.addf("strings options on a non-string field")
+		} else if _, err := compileStrings(strs, v.pf.stringSize); err != nil {
+			v.addf("invalid strings options: %v", err)
+		}
+		return
+	}
+	if b := gen.GetBytes(); b != nil {
+		v.validateBytes(target, b)
+		return
+	}
+	if emb := gen.GetEmbedded(); emb != nil {
+		v.validateEmbedded(target, emb)
+		return
+	}
+	if pattern := gen.GetPattern(); pattern != "" {
+		if target.Kind() != protoreflect.StringKind && target.Kind() != protoreflect.BytesKind {
+			v.addf("pattern on a non-string field")
+		} else if _, err := compilePattern(pattern); err != nil {
+			v.addf("invalid pattern %q: %v", pattern, err)
+		}
+		return
+	}
+	if gen.GetTag() == "" && gen.GetTemplate() == "" {
+		return
+	}
+
+	if target.Kind() == protoreflect.MessageKind || target.Kind() == protoreflect.GroupKind {
+		switch target.Message().FullName() {
+		case wktTimestampFQN, wktDurationFQN, wktAnyFQN:
+		default:
+			v.addf("tag or template is ignored on message fields")
+			return
+		}
+	}
+
+	if tpl := gen.GetTemplate(); tpl != "" {
+		if _, err := compileTemplate(tpl, v.pf.tplOptions); err != nil {
+			v.addf("invalid template: %v", err)
+		}
+		return
+	}
+
+	tag := compileTag(gen.GetTag())
+	for _, name := range tag.unknown {
+		v.addf("unknown tag function %q", name)
+	}
+	isAny := target.Kind() == protoreflect.MessageKind && target.Message().FullName() == wktAnyFQN
+	if tag.constant() && !isAny {
+		if _, err := v.pf.fakeParse(target, tag.raw); err != nil {
+			v.addf("tag %q is not a valid %s: %v", tag.raw, kindName(target), err)
+		}
+	}
+}
+
+func (v *validator) validateBytes(target protoreflect.FieldDescriptor, src *pb.Bytes) {
+	if target.Kind() != protoreflect.BytesKind {
+		v.addf("bytes options on a non-bytes field")
+		return
+	}
+	v.validateRange(src.GetRandom().GetRange())
+	source := bytesSource(src)
+	if source == nil {
+		return
+	}
+	v.validate(target, source, false)
+	if tag := compileTag(source.GetTag()); source.GetTag() != "" && tag.constant() {
+		if _, err := decodeBytes(src.GetEncoding(), tag.raw); err != nil {
+			v.addf("tag %q is not valid %s: %v", tag.raw, src.GetEncoding(), err)
+		}
+	}
+}
+
+func (v *validator) validateEmbedded(target protoreflect.FieldDescriptor, emb *pb.Embedded) {
+	kind := target.Kind()
+	if kind != protoreflect.StringKind && kind != protoreflect.BytesKind {
+		v.addf("embedded options on a field other than string or bytes")
+		return
+	}
+	if _, err := v.pf.types.FindMessageByName(protoreflect.FullName(emb.GetType())); err != nil {
+		v.addf("unknown embedded message type %q", emb.GetType())
+	}
+	if kind == protoreflect.StringKind && !emb.GetBase64() &&
+		embeddedEncoding(emb, kind) == pb.EmbeddedEncoding_EMBEDDED_ENCODING_BINARY {
+		v.addf("%v", errBinaryString)
+	}
+}
+
+func (v *validator) validateRange(rng *pb.Range) {
+	if rng != nil && rng.GetMin() > rng.GetMax() {
+		v.addf("range minimum %d is greater than maximum %d", rng.GetMin(), rng.GetMax())
+	}
+}
+
+// kindName describes the type of values of desc.
+func kindName(desc protoreflect.FieldDescriptor) string {
+	if msg := desc.Message(); msg != nil {
+		return string(msg.FullName())
+	}
+	return desc.Kind().String()
+}
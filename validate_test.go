@@ -0,0 +1,121 @@
+package protogofakeit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	pb "github.com/rodaine/protogofakeit/gen/gofakeit"
+	"github.com/rodaine/protogofakeit/gen/gofakeit/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		tplOpts := &gofakeit.TemplateOptions{
+			Funcs: map[string]any{"custom_func": func() string { return "foo" }},
+		}
+		// WKTTimestampCustom requires a custom timestamp format, checked below.
+		for _, file := range []protoreflect.FileDescriptor{
+			test.File_gofakeit_test_map_proto,
+			test.File_gofakeit_test_message_proto,
+			test.File_gofakeit_test_oneofs_proto,
+			test.File_gofakeit_test_repeated_proto,
+			test.File_gofakeit_test_scalar_proto,
+			test.File_gofakeit_test_smart_proto,
+			test.File_gofakeit_test_template_proto,
+			test.File_gofakeit_test_wkt_proto,
+		} {
+			for _, issue := range Validate(file, WithTemplateOptions(tplOpts)) {
+				assert.Equal(t, "gofakeit.test.WKTTimestampCustom", string(issue.Field.Parent().FullName()), issue.String())
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		var issues []string
+		for _, issue := range Validate(test.File_gofakeit_test_invalid_proto) {
+			issues = append(issues, issue.String())
+		}
+		assert.Equal(t, []string{
+			"gofakeit.test.Invalid.list_range: range minimum 5 is greater than maximum 1",
+			"gofakeit.test.Invalid.map_range: range minimum 3 is greater than maximum 2",
+			"gofakeit.test.Invalid.message_tag: tag or template is ignored on message fields",
+			"gofakeit.test.Invalid.repeated_singular: repeated options on a non-repeated field",
+			"gofakeit.test.Invalid.map_list: map options on a non-map field",
+			`gofakeit.test.Invalid.template: invalid template: template: CodeRun:1: unclosed action`,
+			`gofakeit.test.Invalid.unknown_func: unknown tag function "notafunction"`,
+			`gofakeit.test.Invalid.constant: tag "abc" is not a valid int32: strconv.ParseInt: parsing "abc": invalid syntax`,
+			"gofakeit.test.Invalid.map_value_tag: tag or template is ignored on message fields",
+			`gofakeit.test.Invalid.timestamp: tag "yesterday" is not a valid google.protobuf.Timestamp: ` +
+				`parsing time "yesterday" as "2006-01-02T15:04:05.999999999Z07:00": cannot parse "yesterday" as "2006"`,
+			"gofakeit.test.Invalid.strings_int: strings options on a non-string field",
+			`gofakeit.test.Invalid.unknown_script: invalid strings options: unknown script "Klingon"`,
+			`gofakeit.test.Invalid.word_boundary: invalid pattern "\\bfoo": unsupported word boundary "\\b"`,
+			"gofakeit.test.Invalid.int_pattern: pattern on a non-string field",
+			"gofakeit.test.Invalid.bytes_string: bytes options on a non-bytes field",
+			`gofakeit.test.Invalid.bad_hex: tag "zz" is not valid BYTES_ENCODING_HEX: encoding/hex: invalid byte: U+007A 'z'`,
+			"gofakeit.test.Invalid.embedded_int: embedded options on a field other than string or bytes",
+			`gofakeit.test.Invalid.embedded_unknown: unknown embedded message type "gofakeit.test.Missing"`,
+			"gofakeit.test.Invalid.embedded_binary: binary embedded messages on string fields require base64",
+			"gofakeit.test.Invalid.copy_element: copy_from on list elements or map entries",
+			`gofakeit.test.Invalid.bad_cel: invalid cel expression "this.missing": ` +
+				"ERROR: <input>:1:5: undefined field 'missing'\n | this.missing\n | ....^",
+			"gofakeit.test.Invalid.cel_element: cel on list elements or map entries",
+			`gofakeit.test.Invalid.unknown_reference: invalid reference "missing": gofakeit.test.Invalid has no field "missing"`,
+			`gofakeit.test.Invalid.copy_mismatch: copy_from "cycle_a" has type string, not int32`,
+			"gofakeit.test.Invalid.cycle_a: reference cycle: cycle_a -> cycle_b -> cycle_a",
+			`gofakeit.test.Invalid.Nested.element: tag "1.5" is not a valid int64: strconv.ParseInt: parsing "1.5": invalid syntax`,
+			`gofakeit.test.InvalidOwner.ages: tag "abc" is not a valid int32: strconv.ParseInt: parsing "abc": invalid syntax`,
+		}, issues)
+	})
+
+	t.Run("message", func(t *testing.T) {
+		t.Parallel()
+		issues := Validate((&test.Invalid_Nested{}).ProtoReflect().Descriptor())
+		assert.Len(t, issues, 1)
+
+		issues = Validate((&test.CustomTemplate{}).ProtoReflect().Descriptor())
+		assert.Len(t, issues, 1)
+
+		desc := (&test.WKTTimestampCustom{}).ProtoReflect().Descriptor()
+		assert.Len(t, Validate(desc), 1)
+		assert.Empty(t, Validate(desc, WithTimestampFormat("Jan _2 2006 15:04:05")))
+	})
+
+	t.Run("overlay", func(t *testing.T) {
+		t.Parallel()
+		issues := Validate((&test.Invalid_Nested{}).ProtoReflect().Descriptor(),
+			WithOverlay(strings.NewReader(`fields: {gofakeit.test.Invalid.Nested.element: {tag: "{number:1,10}"}}`)))
+		assert.Empty(t, issues)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+		desc := (&test.Invalid_Nested{}).ProtoReflect().Descriptor()
+		issues := Validate(desc,
+			WithOverlay(strings.NewReader(`fields: {gofakeit.test.Invalid.Nested.missing: {tag: "x"}}`)),
+			WithRules(&pb.Rule{Name: "(["}),
+		)
+		require.Len(t, issues, 3)
+		assert.Nil(t, issues[0].Field)
+		assert.Equal(t, `unknown overlay field "gofakeit.test.Invalid.Nested.missing"`, issues[1].String())
+		assert.Contains(t, issues[0].String(), "invalid rule")
+		assert.Equal(t, desc.Fields().Get(0), issues[2].Field)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		desc := (&test.Invalid_Nested{}).ProtoReflect().Descriptor().Fields().Get(0)
+		issues := Validate(desc)
+		require.Len(t, issues, 1)
+		assert.Nil(t, issues[0].Field)
+		assert.Contains(t, issues[0].String(), "unsupported descriptor")
+	})
+}